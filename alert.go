@@ -0,0 +1,132 @@
+package statist
+
+import (
+	"sync"
+	"time"
+)
+
+// AlertEvent describes a member whose state matched a Rule
+type AlertEvent struct {
+	Rule  string // the Name of the Rule which matched
+	Name  string
+	State string
+	Time  time.Time
+}
+
+// Rule calls Action when Match reports true for a member's name and state;
+// the state is the raw State of a TimedStatist and the StateString (less its leading "Name: ") of any other member.
+// A Rule fires when a member starts matching and, while it keeps matching, again each time Cooldown has passed
+// (never, if Cooldown is zero); a member which flaps in and out of matching is not fired for more than once per Cooldown
+type Rule struct {
+	Name     string
+	Match    func(name, state string) bool
+	Action   func(AlertEvent)
+	Cooldown time.Duration
+}
+
+// Alerts holds a set of Rules and remembers which members they have fired for;
+// attach it to a Registry with Alert or to a Scheduler with its Alerts field, so it is tested with the states
+// each muster reads, or Check a Lineup by hand.
+// Actions are called synchronously, so one which pages someone should hand off to its own goroutine
+type Alerts struct {
+	rules []Rule
+
+	mu    sync.Mutex
+	state map[alertKey]*alertState
+}
+
+// alertKey is a Rule, by index, and a member, by Name()
+type alertKey struct {
+	rule int
+	name string
+}
+
+// alertState is whether a member matched a Rule when last seen, and when the Rule last fired for it
+type alertState struct {
+	matching bool
+	fired    time.Time
+}
+
+// NewAlerts returns Alerts for the given Rules
+func NewAlerts(rules ...Rule) *Alerts {
+	return &Alerts{rules: rules, state: make(map[alertKey]*alertState)}
+}
+
+// Check evaluates the Lineup as Muster does, reading every member, and tests each member against every Rule;
+// a member with unmet dependencies is tested with its flagged line as its state
+func (a *Alerts) Check(l Lineup) {
+	now := time.Now()
+	l.evaluate(func(i int, unmet []string) {
+		if len(unmet) > 0 {
+			a.test(l[i].Name(), unmetLine(l[i], unmet), now)
+			return
+		}
+		a.test(l[i].Name(), stateOf(l[i]), now)
+	})
+}
+
+// Observe tests a single state change against every Rule
+func (a *Alerts) Observe(e StateEvent) {
+	a.test(e.Name, e.New, e.Time)
+}
+
+// test fires any Rule which matches the named member's state at t and is not cooling down
+func (a *Alerts) test(name, state string, t time.Time) {
+	var fire []AlertEvent
+	var actions []func(AlertEvent)
+	a.mu.Lock()
+	for i, r := range a.rules {
+		k := alertKey{rule: i, name: name}
+		st, ok := a.state[k]
+		if !ok {
+			st = &alertState{}
+			a.state[k] = st
+		}
+		if !r.Match(name, state) {
+			st.matching = false
+			continue
+		}
+		due := st.fired.IsZero() || t.Sub(st.fired) >= r.Cooldown
+		if st.matching && r.Cooldown == 0 {
+			due = false
+		}
+		st.matching = true
+		if !due {
+			continue
+		}
+		st.fired = t
+		fire = append(fire, AlertEvent{Rule: r.Name, Name: name, State: state, Time: t})
+		actions = append(actions, r.Action)
+	}
+	a.mu.Unlock()
+	for i, e := range fire {
+		actions[i](e)
+	}
+}
+
+// Alert attaches a to the Registry, so its Rules are tested with the states read by every muster of the Registry and every state change
+// of a Watchable member, and returns a function which detaches it
+func (r *Registry) Alert(a *Alerts) func() {
+	r.subMu.Lock()
+	defer r.subMu.Unlock()
+	if r.alerts == nil {
+		r.alerts = make(map[*Alerts]struct{})
+	}
+	r.alerts[a] = struct{}{}
+	return func() {
+		r.subMu.Lock()
+		defer r.subMu.Unlock()
+		delete(r.alerts, a)
+	}
+}
+
+// attached returns the Alerts attached to the Registry
+func (r *Registry) attached() []*Alerts {
+	r.subMu.Lock()
+	defer r.subMu.Unlock()
+	alerts := make([]*Alerts, 0, len(r.alerts))
+	for a := range r.alerts {
+		alerts = append(alerts, a)
+	}
+	return alerts
+}
@@ -0,0 +1,57 @@
+// package ble turns BLE advertisements from environment beacons into Statists.
+//
+// The package does not talk to a Bluetooth adapter itself; feed it the Advertisements seen by whichever scanner
+// suits the platform (BlueZ over D-Bus, hcitool, a TinyGo radio, ...). A Tracker decodes each advertisement,
+// enlists a Statist for each newly seen beacon, and deserts beacons which fall silent.
+package ble
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// EnvironmentalSensing is the 16-bit service UUID under which ATC and pvvx thermometer firmware advertise
+const EnvironmentalSensing uint16 = 0x181a
+
+// Advertisement is a single BLE advertisement as reported by a scanner
+type Advertisement struct {
+	Address     string // the advertiser's MAC address, eg "A4:C1:38:1A:2B:3C"
+	LocalName   string
+	RSSI        int // in dBm
+	ServiceData map[uint16][]byte
+	Time        time.Time // when it was received; the zero Time means now
+}
+
+// Reading is the decoded payload of a beacon
+type Reading struct {
+	Celsius   float64
+	Humidity  float64 // percent relative humidity
+	Battery   int     // percent
+	Millivolt int
+}
+
+// Decoder extracts a Reading from an Advertisement, or returns false if it is not in a format the Decoder knows
+type Decoder func(a Advertisement) (Reading, bool)
+
+// DecodeATC decodes the environmental sensing service data sent by the ATC1441 and pvvx custom firmware
+// for Xiaomi LYWSD03MMC thermometers, in either of their formats
+func DecodeATC(a Advertisement) (Reading, bool) {
+	b := a.ServiceData[EnvironmentalSensing]
+	switch len(b) {
+	case 13: // atc1441: big endian, temperature in tenths of a degree
+		return Reading{
+			Celsius:   float64(int16(binary.BigEndian.Uint16(b[6:]))) / 10,
+			Humidity:  float64(b[8]),
+			Battery:   int(b[9]),
+			Millivolt: int(binary.BigEndian.Uint16(b[10:])),
+		}, true
+	case 15: // pvvx custom: little endian, temperature and humidity in hundredths
+		return Reading{
+			Celsius:   float64(int16(binary.LittleEndian.Uint16(b[6:]))) / 100,
+			Humidity:  float64(binary.LittleEndian.Uint16(b[8:])) / 100,
+			Millivolt: int(binary.LittleEndian.Uint16(b[10:])),
+			Battery:   int(b[12]),
+		}, true
+	}
+	return Reading{}, false
+}
@@ -0,0 +1,208 @@
+package ble
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/eyelight/statist"
+)
+
+// Tracker keeps a Beacon for every decodable advertiser recently heard from
+type Tracker struct {
+	// Timeout is how long a beacon may stay silent before it is deserted
+	Timeout time.Duration
+	// Decoders are tried in order on each advertisement; it defaults to DecodeATC
+	Decoders []Decoder
+	// Names maps addresses to friendly names; unnamed beacons use their LocalName, or failing that their address
+	Names map[string]string
+	// OnEnlist and OnDesert, if set, are called as beacons appear and time out, eg to mirror them into another Lineup
+	OnEnlist func(*Beacon)
+	OnDesert func(*Beacon)
+
+	mu      sync.Mutex
+	lineup  statist.Lineup
+	beacons map[string]*Beacon
+}
+
+// NewTracker returns a Tracker which deserts beacons after timeout without an advertisement
+func NewTracker(timeout time.Duration) *Tracker {
+	return &Tracker{
+		Timeout:  timeout,
+		Decoders: []Decoder{DecodeATC},
+		Names:    make(map[string]string),
+		lineup:   statist.NewLineup(),
+		beacons:  make(map[string]*Beacon),
+	}
+}
+
+// Lineup returns the beacons currently being tracked, in the order they were first heard
+func (t *Tracker) Lineup() statist.Lineup {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append(statist.NewLineup(), t.lineup...)
+}
+
+// Observe decodes an advertisement and updates its Beacon, enlisting a new one if the advertiser is new;
+// it returns false if no Decoder understood the advertisement
+func (t *Tracker) Observe(a Advertisement) bool {
+	if a.Time.IsZero() {
+		a.Time = time.Now()
+	}
+	var r Reading
+	ok := false
+	for _, d := range t.Decoders {
+		if r, ok = d(a); ok {
+			break
+		}
+	}
+	if !ok {
+		return false
+	}
+	t.mu.Lock()
+	b, seen := t.beacons[a.Address]
+	if !seen {
+		b = &Beacon{id: a.Address, name: t.name(a)}
+		t.beacons[a.Address] = b
+		t.lineup = statist.Enlist(b, t.lineup)
+	}
+	t.mu.Unlock()
+	b.update(a, r)
+	if !seen && t.OnEnlist != nil {
+		t.OnEnlist(b)
+	}
+	return true
+}
+
+// Expire deserts every beacon not heard from within Timeout of now
+func (t *Tracker) Expire(now time.Time) {
+	var gone []*Beacon
+	t.mu.Lock()
+	for id, b := range t.beacons {
+		if now.Sub(b.LastSeen()) > t.Timeout {
+			delete(t.beacons, id)
+			t.lineup = statist.Desert(b, t.lineup)
+			gone = append(gone, b)
+		}
+	}
+	t.mu.Unlock()
+	if t.OnDesert != nil {
+		for _, b := range gone {
+			t.OnDesert(b)
+		}
+	}
+}
+
+// ErrInvalidTimeout is returned by Run when the Tracker's Timeout is too short to expire beacons by
+var ErrInvalidTimeout = errors.New("ble: tracker timeout must be positive")
+
+// Run observes advertisements from ads and expires silent beacons until ctx is done, returning ctx.Err(),
+// or until ads is closed, returning nil; Timeout must be positive
+func (t *Tracker) Run(ctx context.Context, ads <-chan Advertisement) error {
+	if t.Timeout/2 <= 0 {
+		return ErrInvalidTimeout
+	}
+	tick := time.NewTicker(t.Timeout / 2)
+	defer tick.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case a, ok := <-ads:
+			if !ok {
+				return nil
+			}
+			t.Observe(a)
+		case now := <-tick.C:
+			t.Expire(now)
+		}
+	}
+}
+
+// name picks the name of a new beacon; t.mu must be held
+func (t *Tracker) name(a Advertisement) string {
+	if n, ok := t.Names[a.Address]; ok {
+		return n
+	}
+	if a.LocalName != "" {
+		return a.LocalName
+	}
+	return a.Address
+}
+
+// Beacon is the Statist for a single advertiser, identified by its address
+type Beacon struct {
+	id   string
+	name string
+
+	mu       sync.Mutex
+	reading  Reading
+	rssi     int
+	lastSeen time.Time
+}
+
+// update records a decoded advertisement
+func (b *Beacon) update(a Advertisement, r Reading) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.reading = r
+	b.rssi = a.RSSI
+	b.lastSeen = a.Time
+}
+
+// ID returns the beacon's address, which stays put if it is renamed
+func (b *Beacon) ID() string {
+	return b.id
+}
+
+// Name returns the beacon's friendly name
+func (b *Beacon) Name() string {
+	return b.name
+}
+
+// Reading returns the most recently decoded values
+func (b *Beacon) Reading() Reading {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.reading
+}
+
+// RSSI returns the signal strength of the most recent advertisement, in dBm
+func (b *Beacon) RSSI() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.rssi
+}
+
+// LastSeen returns when the most recent advertisement was received
+func (b *Beacon) LastSeen() time.Time {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.lastSeen
+}
+
+// State returns the beacon's latest temperature and when it was heard
+func (b *Beacon) State() (string, time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return strconv.FormatFloat(b.reading.Celsius, 'f', 1, 64), b.lastSeen
+}
+
+// StateString returns the beacon's latest values, eg "Bedroom: 21.3°C 48% battery 91% (-67 dBm)"
+func (b *Beacon) StateString() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	r := b.reading
+	return b.name + ": " +
+		strconv.FormatFloat(r.Celsius, 'f', 1, 64) + "°C " +
+		strconv.FormatFloat(r.Humidity, 'f', 0, 64) + "% " +
+		"battery " + strconv.Itoa(r.Battery) + "% " +
+		"(" + strconv.Itoa(b.rssi) + " dBm)"
+}
+
+// Describe returns the beacon's metadata
+func (b *Beacon) Describe() statist.Description {
+	return statist.Description{Type: "temperature/humidity", Unit: "°C/%RH"}
+}
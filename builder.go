@@ -0,0 +1,174 @@
+package statist
+
+import (
+	"sync"
+	"time"
+)
+
+// funcStatist is a Statist whose state comes from a function
+type funcStatist struct {
+	name string
+	fn   func() string
+}
+
+// Func returns a Statist called name whose StateString is name followed by the result of fn, eg "disk: 81% full"
+func Func(name string, fn func() string) Statist {
+	return &funcStatist{name: name, fn: fn}
+}
+
+// Name returns the name given to Func
+func (f *funcStatist) Name() string {
+	return f.name
+}
+
+// StateString calls the function and returns its result after the name
+func (f *funcStatist) StateString() string {
+	return f.name + ": " + f.fn()
+}
+
+// Builder assembles an ad-hoc Statist one optional interface at a time;
+// it is itself a Statist, so it can be enlisted as soon as it is built, eg
+//
+//	l = statist.Enlist(statist.New("disk").State(diskFree).WithLevel(diskLevel).WithTimestamp(), l)
+type Builder struct {
+	name  string
+	state func() string
+	s     Statist // the built Statist, each With layering another Wrapper over it
+
+	mu   sync.Mutex
+	last string    // the latest state read
+	at   time.Time // when last was read
+}
+
+// New starts building a Statist called name, whose state is empty until State is given a function
+func New(name string) *Builder {
+	b := &Builder{name: name, state: func() string { return "" }}
+	b.s = Func(name, b.read)
+	return b
+}
+
+// read calls the state function, noting the result and the time
+func (b *Builder) read() string {
+	v := b.state()
+	b.mu.Lock()
+	b.last, b.at = v, time.Now()
+	b.mu.Unlock()
+	return v
+}
+
+// State sets the function providing the state, and returns b
+func (b *Builder) State(fn func() string) *Builder {
+	b.state = fn
+	return b
+}
+
+// WithLevel makes the Statist Leveled, judging its health with fn, and returns b
+func (b *Builder) WithLevel(fn func() Level) *Builder {
+	b.s = &leveledLayer{Statist: b.s, fn: fn}
+	return b
+}
+
+// WithTimestamp makes the Statist a TimedStatist, reporting the time of the latest StateString, and returns b
+func (b *Builder) WithTimestamp() *Builder {
+	b.s = &timedLayer{Statist: b.s, b: b}
+	return b
+}
+
+// WithID makes the Statist an Identifier with the given stable ID, and returns b
+func (b *Builder) WithID(id string) *Builder {
+	b.s = &idLayer{Statist: b.s, id: id}
+	return b
+}
+
+// WithPriority makes the Statist Prioritized, and returns b
+func (b *Builder) WithPriority(p int) *Builder {
+	b.s = &priorityLayer{Statist: b.s, p: p}
+	return b
+}
+
+// Name returns the name given to New
+func (b *Builder) Name() string {
+	return b.name
+}
+
+// StateString returns the name followed by the state
+func (b *Builder) StateString() string {
+	return b.s.StateString()
+}
+
+// Unwrap returns the built Statist, through which the capability helpers find whatever was added with With
+func (b *Builder) Unwrap() Statist {
+	return b.s
+}
+
+// leveledLayer adds a Level to the Statist it wraps
+type leveledLayer struct {
+	Statist
+	fn func() Level
+}
+
+// Unwrap returns the Statist beneath this layer
+func (w *leveledLayer) Unwrap() Statist {
+	return w.Statist
+}
+
+// Level returns the result of the level function
+func (w *leveledLayer) Level() Level {
+	return w.fn()
+}
+
+// idLayer adds an ID to the Statist it wraps
+type idLayer struct {
+	Statist
+	id string
+}
+
+// Unwrap returns the Statist beneath this layer
+func (w *idLayer) Unwrap() Statist {
+	return w.Statist
+}
+
+// ID returns the stable ID
+func (w *idLayer) ID() string {
+	return w.id
+}
+
+// priorityLayer adds a Priority to the Statist it wraps
+type priorityLayer struct {
+	Statist
+	p int
+}
+
+// Unwrap returns the Statist beneath this layer
+func (w *priorityLayer) Unwrap() Statist {
+	return w.Statist
+}
+
+// Priority returns the priority
+func (w *priorityLayer) Priority() int {
+	return w.p
+}
+
+// timedLayer adds the time of the latest read to the Statist it wraps
+type timedLayer struct {
+	Statist
+	b *Builder
+}
+
+// Unwrap returns the Statist beneath this layer
+func (w *timedLayer) Unwrap() Statist {
+	return w.Statist
+}
+
+// State returns the latest state read and when it was read, reading it first if it never has been
+func (w *timedLayer) State() (string, time.Time) {
+	w.b.mu.Lock()
+	read := !w.b.at.IsZero()
+	w.b.mu.Unlock()
+	if !read {
+		w.b.read()
+	}
+	w.b.mu.Lock()
+	defer w.b.mu.Unlock()
+	return w.b.last, w.b.at
+}
@@ -0,0 +1,167 @@
+package statist
+
+import (
+	"strings"
+)
+
+// CapsSet is a set of the optional interfaces a Statist implements
+type CapsSet uint
+
+// The optional interfaces which may be present in a CapsSet
+const (
+	CapIdentifier CapsSet = 1 << iota
+	CapDependent
+	CapPrioritized
+	CapFields
+	CapDetailer
+	CapDescriber
+	CapEnumerated
+	CapTimed
+	CapWatchable
+	CapLeveled
+	CapHistorian
+	CapNumeric
+)
+
+// capNames are the names of each capability, in bit order
+var capNames = []string{"Identifier", "Dependent", "Prioritized", "Fields", "Detailer", "Describer", "Enumerated", "Timed", "Watchable", "Leveled", "Historian", "Numeric"}
+
+// Capabilities returns the set of optional interfaces implemented by s
+func Capabilities(s Statist) CapsSet {
+	var c CapsSet
+	if _, ok := AsIdentifier(s); ok {
+		c |= CapIdentifier
+	}
+	if _, ok := AsDependent(s); ok {
+		c |= CapDependent
+	}
+	if _, ok := AsPrioritized(s); ok {
+		c |= CapPrioritized
+	}
+	if _, ok := AsFields(s); ok {
+		c |= CapFields
+	}
+	if _, ok := AsDetailer(s); ok {
+		c |= CapDetailer
+	}
+	if _, ok := AsDescriber(s); ok {
+		c |= CapDescriber
+	}
+	if _, ok := AsEnumerated(s); ok {
+		c |= CapEnumerated
+	}
+	if _, ok := AsTimed(s); ok {
+		c |= CapTimed
+	}
+	if _, ok := AsWatchable(s); ok {
+		c |= CapWatchable
+	}
+	if _, ok := AsLeveled(s); ok {
+		c |= CapLeveled
+	}
+	if _, ok := AsHistorian(s); ok {
+		c |= CapHistorian
+	}
+	if _, ok := AsNumeric(s); ok {
+		c |= CapNumeric
+	}
+	return c
+}
+
+// Has reports whether every capability in caps is in the set
+func (c CapsSet) Has(caps CapsSet) bool {
+	return c&caps == caps
+}
+
+// String returns the names of the capabilities in the set separated by '|', eg "Identifier|Fields"
+func (c CapsSet) String() string {
+	names := make([]string, 0, len(capNames))
+	for i, n := range capNames {
+		if c.Has(1 << i) {
+			names = append(names, n)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// Wrapper may be implemented by a Statist which decorates another (eg, to record its history);
+// the capability helpers look through a Wrapper to the optional interfaces of the Statist it wraps
+type Wrapper interface {
+	Unwrap() Statist
+}
+
+// as returns s, or the first Statist it wraps, as a T, or false if none of them is one
+func as[T any](s Statist) (T, bool) {
+	for s != nil {
+		if t, ok := s.(T); ok {
+			return t, true
+		}
+		w, ok := s.(Wrapper)
+		if !ok {
+			break
+		}
+		s = w.Unwrap()
+	}
+	var zero T
+	return zero, false
+}
+
+// AsIdentifier returns s as an Identifier, or false if it is not one
+func AsIdentifier(s Statist) (Identifier, bool) {
+	return as[Identifier](s)
+}
+
+// AsDependent returns s as a Dependent, or false if it is not one
+func AsDependent(s Statist) (Dependent, bool) {
+	return as[Dependent](s)
+}
+
+// AsPrioritized returns s as Prioritized, or false if it is not
+func AsPrioritized(s Statist) (Prioritized, bool) {
+	return as[Prioritized](s)
+}
+
+// AsFields returns s as a FieldsStatist, or false if it is not one
+func AsFields(s Statist) (FieldsStatist, bool) {
+	return as[FieldsStatist](s)
+}
+
+// AsDetailer returns s as a Detailer, or false if it is not one
+func AsDetailer(s Statist) (Detailer, bool) {
+	return as[Detailer](s)
+}
+
+// AsDescriber returns s as a Describer, or false if it is not one
+func AsDescriber(s Statist) (Describer, bool) {
+	return as[Describer](s)
+}
+
+// AsEnumerated returns s as Enumerated, or false if it is not
+func AsEnumerated(s Statist) (Enumerated, bool) {
+	return as[Enumerated](s)
+}
+
+// AsTimed returns s as a TimedStatist, or false if it is not one
+func AsTimed(s Statist) (TimedStatist, bool) {
+	return as[TimedStatist](s)
+}
+
+// AsWatchable returns s as Watchable, or false if it is not
+func AsWatchable(s Statist) (Watchable, bool) {
+	return as[Watchable](s)
+}
+
+// AsLeveled returns s as Leveled, or false if it is not
+func AsLeveled(s Statist) (Leveled, bool) {
+	return as[Leveled](s)
+}
+
+// AsHistorian returns s as a Historian, or false if it is not one
+func AsHistorian(s Statist) (Historian, bool) {
+	return as[Historian](s)
+}
+
+// AsNumeric returns s as Numeric, or false if it is not
+func AsNumeric(s Statist) (Numeric, bool) {
+	return as[Numeric](s)
+}
@@ -0,0 +1,94 @@
+package statist
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// outcome is the rendering of a member mustered concurrently, and whether it was evaluated in time without error
+type outcome struct {
+	lines string
+	ok    bool
+}
+
+// MusterContext does the same as Muster but evaluates members concurrently, so one slow Statist cannot hold up
+// the rest; a member which takes longer than perStatistTimeout (if positive), or is still running when ctx is done,
+// is reported as timed out, and its dependents are flagged as unmet (as are dependents of a member reporting Error).
+// Output keeps the order of Muster
+func (l Lineup) MusterContext(ctx context.Context, perStatistTimeout time.Duration) string {
+	_, unmet := l.order()
+	byName := make(map[string]int, len(l))
+	for i := len(l) - 1; i >= 0; i-- {
+		byName[l[i].Name()] = i
+	}
+	outcomes := make([]outcome, len(l))
+	done := make([]chan struct{}, len(l))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+	for i := range l {
+		go func(i int) {
+			defer close(done[i])
+			outcomes[i] = l.collect(ctx, i, perStatistTimeout, unmet[l[i].Name()], byName, outcomes, done)
+		}(i)
+	}
+	for _, d := range done {
+		<-d
+	}
+	s := strings.Builder{}
+	s.Grow(1024)
+	for _, i := range l.display() {
+		s.WriteString(outcomes[i].lines)
+		s.WriteByte(NewLine())
+	}
+	return s.String()
+}
+
+// collect waits for the inputs of member i, then evaluates it within the timeout;
+// outcomes of a member may only be read once its done channel is closed
+func (l Lineup) collect(ctx context.Context, i int, timeout time.Duration, unmet []string,
+	byName map[string]int, outcomes []outcome, done []chan struct{}) outcome {
+	v := l[i]
+	if len(unmet) > 0 {
+		return outcome{lines: unmetLine(v, unmet)}
+	}
+	var failed []string
+	for _, d := range dependsOn(v) {
+		j := byName[d]
+		select {
+		case <-done[j]:
+		case <-ctx.Done():
+			return outcome{lines: timedOutLine(v)}
+		}
+		if !outcomes[j].ok {
+			failed = append(failed, d)
+		}
+	}
+	if len(failed) > 0 {
+		return outcome{lines: unmetLine(v, failed)}
+	}
+	// buffered, so a laggard can finish and be forgotten
+	c := make(chan string, 1)
+	go func() {
+		c <- memberLines(v, nil, false)
+	}()
+	var expired <-chan time.Time
+	if timeout > 0 {
+		t := time.NewTimer(timeout)
+		defer t.Stop()
+		expired = t.C
+	}
+	select {
+	case lines := <-c:
+		return outcome{lines: lines, ok: LevelOf(v) != Error}
+	case <-expired:
+	case <-ctx.Done():
+	}
+	return outcome{lines: timedOutLine(v)}
+}
+
+// timedOutLine is the muster line standing in for a member which did not report in time
+func timedOutLine(s Statist) string {
+	return string(X()) + " " + s.Name() + ": timed out"
+}
@@ -0,0 +1,118 @@
+package statist
+
+import (
+	"strings"
+)
+
+// Dependent may be implemented by a Statist whose state is derived from other Statists;
+// DependsOn returns the Name() of each Statist that must be evaluated before it
+type Dependent interface {
+	DependsOn() []string
+}
+
+// Ordered returns a copy of the Lineup in evaluation order, where each Dependent follows the Statists it depends on;
+// members are otherwise kept in enlistment order, and members caught in a dependency cycle are appended last
+func (l Lineup) Ordered() Lineup {
+	idx, _ := l.order()
+	o := make(Lineup, 0, len(l))
+	for _, i := range idx {
+		o = append(o, l[i])
+	}
+	return o
+}
+
+// Unmet returns the dependencies of s which cannot be satisfied by the Lineup,
+// either because they are missing, part of a cycle, or themselves have unmet dependencies
+func (l Lineup) Unmet(s Statist) []string {
+	_, unmet := l.order()
+	return unmet[s.Name()]
+}
+
+// evaluate calls eval for each member in dependency order, passing the unmet dependencies of a member
+// which should be flagged rather than evaluated, then returns the indices of the members in display order;
+// a dependency which is flagged, or which reports Error once evaluated, is failing and so unmet for its dependents
+func (l Lineup) evaluate(eval func(i int, unmet []string)) []int {
+	ordered, unmet := l.order()
+	failing := make(map[string]bool)
+	for _, i := range ordered {
+		v := l[i]
+		deps := unmet[v.Name()]
+		if len(deps) == 0 {
+			for _, d := range dependsOn(v) {
+				if failing[d] {
+					deps = append(deps, d)
+				}
+			}
+		}
+		eval(i, deps)
+		if len(deps) > 0 || LevelOf(v) == Error {
+			failing[v.Name()] = true
+		}
+	}
+	return l.display()
+}
+
+// unmetLine is the muster line standing in for a member with unmet dependencies
+func unmetLine(s Statist, unmet []string) string {
+	return string(X()) + " " + s.Name() + ": unmet dependency " + strings.Join(unmet, ", ")
+}
+
+// order returns the indices of the Lineup in evaluation order and reports, by Name(), the unmet dependencies of each member
+func (l Lineup) order() ([]int, map[string][]string) {
+	present := make(map[string]bool, len(l))
+	for _, v := range l {
+		present[v.Name()] = true
+	}
+	ordered := make([]int, 0, len(l))
+	placed := make(map[string]bool, len(l))
+	unmet := make(map[string][]string)
+	done := make([]bool, len(l))
+	for progress := true; progress; {
+		progress = false
+		for i, v := range l {
+			if done[i] || !ready(v, present, placed) {
+				continue
+			}
+			for _, d := range dependsOn(v) {
+				if !present[d] || len(unmet[d]) > 0 {
+					unmet[v.Name()] = append(unmet[v.Name()], d)
+				}
+			}
+			ordered = append(ordered, i)
+			placed[v.Name()] = true
+			done[i] = true
+			progress = true
+		}
+	}
+	// whatever remains is waiting on a cycle
+	for i, v := range l {
+		if done[i] {
+			continue
+		}
+		for _, d := range dependsOn(v) {
+			if !placed[d] || len(unmet[d]) > 0 {
+				unmet[v.Name()] = append(unmet[v.Name()], d)
+			}
+		}
+		ordered = append(ordered, i)
+	}
+	return ordered, unmet
+}
+
+// ready reports whether every dependency of s present in the Lineup has already been placed
+func ready(s Statist, present, placed map[string]bool) bool {
+	for _, d := range dependsOn(s) {
+		if present[d] && !placed[d] {
+			return false
+		}
+	}
+	return true
+}
+
+// dependsOn returns the dependencies of s, or nil if s is not a Dependent
+func dependsOn(s Statist) []string {
+	if d, ok := AsDependent(s); ok {
+		return d.DependsOn()
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package statist
+
+// Description is self-documenting metadata about a Statist, for consumers which render or catalogue a Lineup
+type Description struct {
+	Type         string `json:"type,omitempty"` // what kind of thing it is, eg "temperature"
+	Unit         string `json:"unit,omitempty"` // unit of the reported state, eg "°C"
+	Manufacturer string `json:"manufacturer,omitempty"`
+	URL          string `json:"url,omitempty"` // where the documentation lives
+}
+
+// Describer may be implemented by a Statist which can describe itself
+type Describer interface {
+	Describe() Description
+}
+
+// Describe returns the Description of s, or false if s is not a Describer
+func Describe(s Statist) (Description, bool) {
+	if d, ok := AsDescriber(s); ok {
+		return d.Describe(), true
+	}
+	return Description{}, false
+}
+
+// Descriptions returns the Description of each Describer in the Lineup, keyed by Name()
+func (l Lineup) Descriptions() map[string]Description {
+	m := make(map[string]Description)
+	for _, v := range l {
+		if d, ok := Describe(v); ok {
+			m[v.Name()] = d
+		}
+	}
+	return m
+}
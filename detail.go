@@ -0,0 +1,37 @@
+package statist
+
+import (
+	"strings"
+)
+
+// Detailer may be implemented by a Statist with diagnostics too long for its muster line (eg, last error text, a JSON dump);
+// the detail is included by MusterDetailed but omitted by the compact Muster and MusterWithGreeting
+type Detailer interface {
+	Detail() string
+}
+
+// MusterDetailed does the same as Muster but also includes each member's Detail(), indented beneath its line
+func (l Lineup) MusterDetailed() string {
+	s := strings.Builder{}
+	s.Grow(4096)
+	l.writeMembers(&s, nil, true)
+	return s.String()
+}
+
+// writeDetail writes each line of the Detail() of s indented by two tabs, if s is a Detailer with something to say
+func writeDetail(b *strings.Builder, s Statist) {
+	d, ok := AsDetailer(s)
+	if !ok {
+		return
+	}
+	detail := strings.TrimRight(d.Detail(), "\n")
+	if detail == "" {
+		return
+	}
+	for _, line := range strings.Split(detail, "\n") {
+		b.WriteByte(NewLine())
+		b.WriteByte(Tab())
+		b.WriteByte(Tab())
+		b.WriteString(line)
+	}
+}
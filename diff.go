@@ -0,0 +1,90 @@
+package statist
+
+import (
+	"strings"
+	"sync"
+)
+
+// Differ is a Musterer which only reports members whose state has changed since its previous muster
+type Differ struct {
+	lineup func() Lineup
+	alerts func() []*Alerts // attached to the Registry the Differ is over, if any
+
+	mu   sync.Mutex
+	last map[string]string // the lines last reported, by IDOf
+}
+
+// NewDiffer returns a Differ over l; its first muster reports every member
+func NewDiffer(l Lineup) *Differ {
+	return &Differ{
+		lineup: func() Lineup { return l },
+		alerts: func() []*Alerts { return nil },
+		last:   make(map[string]string),
+	}
+}
+
+// Differ returns a Differ over the Registry's current members; a newly enlisted member is reported as changed,
+// and Alerts attached to the Registry are tested by its musters as they are by the Registry's own
+func (r *Registry) Differ() *Differ {
+	return &Differ{
+		lineup: r.Lineup,
+		alerts: r.attached,
+		last:   make(map[string]string),
+	}
+}
+
+// Muster does the same as Lineup.Muster but only writes members whose lines differ from the previous muster,
+// and returns an empty string if none do
+func (d *Differ) Muster() string {
+	return d.musterAlerts(nil)
+}
+
+// MusterWithGreeting does the same as Muster with a greeting, but returns an empty string if nothing changed
+func (d *Differ) MusterWithGreeting(g string) string {
+	return d.musterAlerts(&g)
+}
+
+// musterAlerts musters the changed members after the greeting g, if there is one and anything changed,
+// testing every member against alerts as well as any attached to the Registry
+func (d *Differ) musterAlerts(g *string, alerts ...*Alerts) string {
+	s := strings.Builder{}
+	if !d.writeChanged(&s, alerts) {
+		return ""
+	}
+	if g != nil {
+		return *g + string(NewLine()) + s.String()
+	}
+	return s.String()
+}
+
+// Reset forgets what was reported, so the next muster reports every member
+func (d *Differ) Reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.last = make(map[string]string)
+}
+
+// writeChanged evaluates the whole Lineup, writes the members which changed, and reports whether any did;
+// every member is then tested against alerts, changed or not
+func (d *Differ) writeChanged(s *strings.Builder, alerts []*Alerts) bool {
+	l := d.lineup()
+	states, raw, display := l.read(nil, false)
+	defer l.alert(raw, append(d.alerts(), alerts...))
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	last := make(map[string]string, len(l))
+	changed := false
+	for _, i := range display {
+		id := IDOf(l[i])
+		last[id] = states[i]
+		if prev, ok := d.last[id]; ok && prev == states[i] {
+			continue
+		}
+		s.WriteString(states[i])
+		s.WriteByte(NewLine())
+		changed = true
+	}
+	// members which have left are forgotten, so they are reported again should they return
+	d.last = last
+	return changed
+}
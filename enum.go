@@ -0,0 +1,47 @@
+package statist
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidState is returned when a state is outside the vocabulary of an Enumerated Statist
+var ErrInvalidState = errors.New("statist: invalid state")
+
+// Enumerated may be implemented by a Statist whose state is one of a fixed vocabulary (eg, open, closed, jammed);
+// a Statist with a SetState method should call ValidState so that typos are caught at the source
+type Enumerated interface {
+	States() []string
+}
+
+// ValidState returns an error wrapping ErrInvalidState if s is Enumerated and state is not among its States();
+// an empty vocabulary allows any state
+func ValidState(s Statist, state string) error {
+	e, ok := AsEnumerated(s)
+	if !ok {
+		return nil
+	}
+	states := e.States()
+	if len(states) == 0 {
+		return nil
+	}
+	for _, v := range states {
+		if v == state {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w %q for %s, want one of %q", ErrInvalidState, state, s.Name(), states)
+}
+
+// Vocabularies returns the States() of each Enumerated member of the Lineup with a non-empty vocabulary, keyed by Name()
+func (l Lineup) Vocabularies() map[string][]string {
+	m := make(map[string][]string)
+	for _, v := range l {
+		if e, ok := AsEnumerated(v); ok {
+			if states := e.States(); len(states) > 0 {
+				m[v.Name()] = states
+			}
+		}
+	}
+	return m
+}
@@ -0,0 +1,38 @@
+package statist
+
+import (
+	"sort"
+	"strings"
+)
+
+// FieldsStatist may be implemented by a Statist which reports several named values (eg, voltage, current, power);
+// each field is rendered on its own indented line beneath the member's StateString
+type FieldsStatist interface {
+	Fields() map[string]string
+}
+
+// FieldNames returns the keys of a Fields() map in sorted order, which is the order they are rendered in
+func FieldNames(fields map[string]string) []string {
+	names := make([]string, 0, len(fields))
+	for k := range fields {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// writeFields writes a tab-indented line for each of the fields of s, if s is a FieldsStatist
+func writeFields(b *strings.Builder, s Statist) {
+	f, ok := AsFields(s)
+	if !ok {
+		return
+	}
+	fields := f.Fields()
+	for _, k := range FieldNames(fields) {
+		b.WriteByte(NewLine())
+		b.WriteByte(Tab())
+		b.WriteString(k)
+		b.WriteString(": ")
+		b.WriteString(fields[k])
+	}
+}
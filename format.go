@@ -0,0 +1,59 @@
+package statist
+
+import (
+	"strings"
+)
+
+// LineFormatter renders the muster line of a Statist in place of its StateString
+type LineFormatter func(s Statist) string
+
+// Formatters overrides how particular Statists are rendered in a muster, either individually (by IDOf)
+// or by the Type of their Description (eg, every "battery" renders as "name: 87% 🔋"), without wrapping them
+type Formatters struct {
+	byID   map[string]LineFormatter
+	byType map[string]LineFormatter
+}
+
+// NewFormatters returns an empty set of Formatters
+func NewFormatters() *Formatters {
+	return &Formatters{
+		byID:   make(map[string]LineFormatter),
+		byType: make(map[string]LineFormatter),
+	}
+}
+
+// ForID registers fn to render the Statist whose IDOf is id, and returns f for chaining
+func (f *Formatters) ForID(id string, fn LineFormatter) *Formatters {
+	f.byID[id] = fn
+	return f
+}
+
+// ForType registers fn to render every Describer whose Description has the given Type, and returns f for chaining;
+// a formatter registered ForID takes precedence
+func (f *Formatters) ForType(typ string, fn LineFormatter) *Formatters {
+	f.byType[typ] = fn
+	return f
+}
+
+// line renders s with a matching LineFormatter, falling back to its StateString
+func (f *Formatters) line(s Statist) string {
+	if f != nil {
+		if fn, ok := f.byID[IDOf(s)]; ok {
+			return fn(s)
+		}
+		if d, ok := Describe(s); ok {
+			if fn, ok := f.byType[d.Type]; ok {
+				return fn(s)
+			}
+		}
+	}
+	return s.StateString()
+}
+
+// MusterFormatted does the same as Muster but renders members with any matching Formatters
+func (l Lineup) MusterFormatted(f *Formatters) string {
+	s := strings.Builder{}
+	s.Grow(1024)
+	l.writeMembers(&s, f, false)
+	return s.String()
+}
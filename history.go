@@ -0,0 +1,140 @@
+package statist
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// StateRecord is a state a Statist reported and when it reported it
+type StateRecord struct {
+	State string
+	Time  time.Time
+}
+
+// Historian may be implemented by a Statist which remembers its recent states, oldest first
+type Historian interface {
+	History() []StateRecord
+}
+
+// History is a Wrapper which records the last few distinct states of a Statist
+type History struct {
+	s Statist
+
+	mu      sync.Mutex
+	cancel  func()        // stops the watch on s, if it is Watchable
+	records []StateRecord // a ring buffer
+	start   int           // index of the oldest record
+	count   int
+}
+
+// WithHistory wraps s so that its last n distinct states are recorded as they are observed;
+// states are noted whenever the wrapper's StateString is called and, if s is Watchable, on every change.
+// If s is a TimedStatist its raw State and timestamp are recorded, otherwise its StateString and the time it was read.
+// Close the History once it is no longer needed, so a Watchable s stops recording into it
+func WithHistory(s Statist, n int) *History {
+	if n < 1 {
+		n = 1
+	}
+	h := &History{s: s, records: make([]StateRecord, n)}
+	if w, ok := AsWatchable(s); ok {
+		cancel := w.Watch(func(e StateEvent) {
+			h.record(StateRecord{State: e.New, Time: e.Time})
+		})
+		h.mu.Lock()
+		h.cancel = cancel
+		h.mu.Unlock()
+	}
+	return h
+}
+
+// Close stops recording the changes of a Watchable Statist, leaving the recorded states in place;
+// it is safe to call more than once
+func (h *History) Close() {
+	h.mu.Lock()
+	cancel := h.cancel
+	h.cancel = nil
+	h.mu.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+}
+
+// Unwrap returns the wrapped Statist
+func (h *History) Unwrap() Statist {
+	return h.s
+}
+
+// Name returns the Name of the wrapped Statist
+func (h *History) Name() string {
+	return h.s.Name()
+}
+
+// StateString returns the StateString of the wrapped Statist, recording its state if it has changed
+func (h *History) StateString() string {
+	str := h.s.StateString()
+	r := StateRecord{State: str, Time: time.Now()}
+	if t, ok := AsTimed(h.s); ok {
+		r.State, r.Time = t.State()
+	}
+	h.record(r)
+	return str
+}
+
+// History returns the recorded states, oldest first
+func (h *History) History() []StateRecord {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	out := make([]StateRecord, 0, h.count)
+	for i := 0; i < h.count; i++ {
+		out = append(out, h.records[(h.start+i)%len(h.records)])
+	}
+	return out
+}
+
+// record appends r unless it repeats the latest record, overwriting the oldest once the buffer is full
+func (h *History) record(r StateRecord) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.count > 0 && h.records[(h.start+h.count-1)%len(h.records)].State == r.State {
+		return
+	}
+	if h.count < len(h.records) {
+		h.records[(h.start+h.count)%len(h.records)] = r
+		h.count++
+		return
+	}
+	h.records[h.start] = r
+	h.start = (h.start + 1) % len(h.records)
+}
+
+// MusterHistory does the same as Muster, then writes the recorded states of each Historian beneath its line
+// as a timeline, oldest first
+func (l Lineup) MusterHistory() string {
+	states := make([]string, len(l))
+	display := l.evaluate(func(i int, unmet []string) {
+		if len(unmet) > 0 {
+			states[i] = unmetLine(l[i], unmet)
+		} else {
+			states[i] = memberLines(l[i], nil, false)
+		}
+	})
+	s := strings.Builder{}
+	s.Grow(4096)
+	for _, i := range display {
+		s.WriteString(states[i])
+		s.WriteByte(NewLine())
+		h, ok := AsHistorian(l[i])
+		if !ok {
+			continue
+		}
+		for _, r := range h.History() {
+			s.WriteByte(Tab())
+			s.WriteString(r.Time.Format(time.RFC3339))
+			s.WriteByte(' ')
+			s.WriteString(r.State)
+			s.WriteByte(NewLine())
+		}
+	}
+	return s.String()
+}
@@ -0,0 +1,86 @@
+package statist
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// handler serves the members of a Lineup over HTTP
+type handler struct {
+	lineup func() Lineup
+}
+
+// Handler returns an http.Handler serving l: the plain-text Muster at /, MusterJSON at /json,
+// MusterPrometheus at /metrics, the Descriptions of its Describers at /describe,
+// and a single member's StateString at /state/{name}.
+// Responses are 503 Service Unavailable when any member concerned reports Error, so the handler can double
+// as a healthcheck; mount it under a prefix with http.StripPrefix
+func Handler(l Lineup) http.Handler {
+	return &handler{lineup: func() Lineup { return l }}
+}
+
+// Handler returns an http.Handler serving the Registry's current members, as the Handler function does
+func (r *Registry) Handler() http.Handler {
+	return &handler{lineup: r.Lineup}
+}
+
+// ServeHTTP routes requests to the muster, JSON, metrics, description, or state endpoints
+func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	l := h.lineup()
+	switch path := req.URL.Path; {
+	case path == "/" || path == "":
+		m := l.Muster()
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(status(l))
+		w.Write([]byte(m))
+	case path == "/json":
+		b, err := l.MusterJSON()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status(l))
+		w.Write(b)
+	case path == "/metrics":
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		w.Write([]byte(l.MusterPrometheus()))
+	case path == "/describe":
+		b, err := json.Marshal(l.Descriptions())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	case strings.HasPrefix(path, "/state/"):
+		name := strings.TrimPrefix(path, "/state/")
+		for _, v := range l {
+			if v.Name() == name {
+				s := v.StateString()
+				w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+				w.WriteHeader(status(Lineup{v}))
+				w.Write([]byte(s))
+				w.Write([]byte{NewLine()})
+				return
+			}
+		}
+		http.NotFound(w, req)
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+// status returns 503 Service Unavailable if any member of l reports Error, and 200 OK otherwise
+func status(l Lineup) int {
+	if l.Worst() >= Error {
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusOK
+}
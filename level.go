@@ -0,0 +1,90 @@
+package statist
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Level is the health of a Statist, ordered from best to worst so that levels may be compared
+type Level int
+
+const (
+	OK Level = iota
+	Unknown
+	Warn
+	Error
+)
+
+// String returns the name of the Level
+func (v Level) String() string {
+	switch v {
+	case OK:
+		return "OK"
+	case Unknown:
+		return "UNKNOWN"
+	case Warn:
+		return "WARN"
+	case Error:
+		return "ERROR"
+	}
+	return "Level(" + strconv.Itoa(int(v)) + ")"
+}
+
+// Leveled may be implemented by a Statist which can judge its own health;
+// a Dependent whose dependency reports Error is flagged rather than evaluated
+type Leveled interface {
+	Level() Level
+}
+
+// LevelOf returns the Level() of s if it is Leveled, and Unknown otherwise
+func LevelOf(s Statist) Level {
+	if v, ok := AsLeveled(s); ok {
+		return v.Level()
+	}
+	return Unknown
+}
+
+// flagged is the Level of a member flagged for unmet or failing dependencies, which cannot report for itself
+const flagged = Error
+
+// Worst returns the worst Level of any member, or OK for an empty Lineup, as an overall health verdict;
+// a member flagged for unmet or failing dependencies counts as Error
+func (l Lineup) Worst() Level {
+	worst := OK
+	l.evaluate(func(i int, unmet []string) {
+		lv := flagged
+		if len(unmet) == 0 {
+			lv = LevelOf(l[i])
+		}
+		if lv > worst {
+			worst = lv
+		}
+	})
+	return worst
+}
+
+// MusterByLevel does the same as Muster but only writes members at or worse than min (eg, Warn for warnings and errors);
+// the whole Lineup is still evaluated, so dependencies resolve as they would in a full muster, and a member flagged
+// for unmet or failing dependencies counts as Error
+func (l Lineup) MusterByLevel(min Level) string {
+	states := make([]string, len(l))
+	keep := make([]bool, len(l))
+	display := l.evaluate(func(i int, unmet []string) {
+		if len(unmet) > 0 {
+			states[i] = unmetLine(l[i], unmet)
+			keep[i] = flagged >= min
+			return
+		}
+		states[i] = memberLines(l[i], nil, false)
+		keep[i] = LevelOf(l[i]) >= min
+	})
+	s := strings.Builder{}
+	s.Grow(1024)
+	for _, i := range display {
+		if keep[i] {
+			s.WriteString(states[i])
+			s.WriteByte(NewLine())
+		}
+	}
+	return s.String()
+}
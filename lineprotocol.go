@@ -0,0 +1,90 @@
+package statist
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MusterLineProtocol renders the Lineup in the InfluxDB line protocol, one line per member under measurement:
+// each line carries the given tags plus the member's own name (and id, where it differs) as tags, the Value of
+// a Numeric as the field value or the state of any other member as the field state, and is timestamped with the
+// refresh time of a TimedStatist or else the time of the muster; name and id among the given tags are ignored,
+// as is any tag (including an empty name) whose value is empty, since InfluxDB rejects those.
+// The state is the raw State of a TimedStatist, or the StateString less its leading "Name: ".
+// Members with unmet dependencies, or a Value which is NaN or infinite, are omitted
+func (l Lineup) MusterLineProtocol(measurement string, tags map[string]string) string {
+	now := time.Now()
+	prefix := strings.Builder{}
+	prefix.WriteString(escapeLine(measurement, ", "))
+	keys := make([]string, 0, len(tags))
+	for k, v := range tags {
+		// InfluxDB rejects a line with an empty tag
+		if k != "name" && k != "id" && k != "" && v != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		prefix.WriteString("," + escapeLine(k, ",= ") + "=" + escapeLine(tags[k], ",= "))
+	}
+	lines := make([]string, len(l))
+	display := l.evaluate(func(i int, unmet []string) {
+		if len(unmet) > 0 {
+			return
+		}
+		v := l[i]
+		b := strings.Builder{}
+		b.WriteString(prefix.String())
+		if id := IDOf(v); id != v.Name() && id != "" {
+			b.WriteString(",id=" + escapeLine(id, ",= "))
+		}
+		if v.Name() != "" {
+			b.WriteString(",name=" + escapeLine(v.Name(), ",= "))
+		}
+		if n, ok := AsNumeric(v); ok {
+			f := n.Value()
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				return
+			}
+			b.WriteString(" value=" + strconv.FormatFloat(f, 'g', -1, 64))
+		} else {
+			b.WriteString(` state="` + escapeLine(stateOf(v), `"\`) + `"`)
+		}
+		at := now
+		if t, ok := AsTimed(v); ok {
+			if _, since := t.State(); !since.IsZero() {
+				at = since
+			}
+		}
+		b.WriteString(" " + strconv.FormatInt(at.UnixNano(), 10))
+		lines[i] = b.String()
+	})
+	s := strings.Builder{}
+	s.Grow(1024)
+	for _, i := range display {
+		if lines[i] == "" {
+			continue
+		}
+		s.WriteString(lines[i])
+		s.WriteByte(NewLine())
+	}
+	return s.String()
+}
+
+// escapeLine backslash-escapes each of the special characters in v, and replaces line feeds (which cannot be escaped) with spaces
+func escapeLine(v, special string) string {
+	b := strings.Builder{}
+	for _, r := range v {
+		if r == rune(NewLine()) {
+			r = ' '
+		}
+		if strings.ContainsRune(special, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
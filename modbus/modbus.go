@@ -0,0 +1,169 @@
+// package modbus exposes Modbus RTU and TCP registers as Statists.
+//
+// Describe each value of interest as a Register, hand them to a Poller along with a TCP or RTU Client,
+// then Enlist the Poller's Lineup and run Poll in a goroutine; musters report the most recently polled
+// values without waiting on the bus.
+package modbus
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Function is a Modbus function code for reading registers
+type Function byte
+
+const (
+	ReadHoldingRegisters Function = 0x03
+	ReadInputRegisters   Function = 0x04
+)
+
+// Client reads registers from a Modbus device
+type Client interface {
+	ReadRegisters(unit byte, fn Function, address, count uint16) ([]uint16, error)
+}
+
+// ExceptionError is returned when a device answers a request with a Modbus exception
+type ExceptionError struct {
+	Function Function
+	Code     byte
+}
+
+// Error describes the exception, naming the common codes
+func (e *ExceptionError) Error() string {
+	names := map[byte]string{
+		1:  "illegal function",
+		2:  "illegal data address",
+		3:  "illegal data value",
+		4:  "server device failure",
+		6:  "server device busy",
+		10: "gateway path unavailable",
+		11: "gateway target device failed to respond",
+	}
+	if n, ok := names[e.Code]; ok {
+		return fmt.Sprintf("modbus: exception %d (%s) for function %#02x", e.Code, n, byte(e.Function))
+	}
+	return fmt.Sprintf("modbus: exception %d for function %#02x", e.Code, byte(e.Function))
+}
+
+// ErrMalformed is returned when a response cannot be parsed
+var ErrMalformed = errors.New("modbus: malformed response")
+
+// request returns the PDU of a read request
+func request(fn Function, address, count uint16) []byte {
+	pdu := make([]byte, 5)
+	pdu[0] = byte(fn)
+	binary.BigEndian.PutUint16(pdu[1:], address)
+	binary.BigEndian.PutUint16(pdu[3:], count)
+	return pdu
+}
+
+// response parses the PDU of a read response into count registers
+func response(pdu []byte, fn Function, count uint16) ([]uint16, error) {
+	if len(pdu) < 2 {
+		return nil, ErrMalformed
+	}
+	if pdu[0] == byte(fn)|0x80 {
+		return nil, &ExceptionError{Function: fn, Code: pdu[1]}
+	}
+	if pdu[0] != byte(fn) || int(pdu[1]) != 2*int(count) || len(pdu) != 2+2*int(count) {
+		return nil, ErrMalformed
+	}
+	regs := make([]uint16, count)
+	for i := range regs {
+		regs[i] = binary.BigEndian.Uint16(pdu[2+2*i:])
+	}
+	return regs, nil
+}
+
+// Format is how the words of a Register are interpreted
+type Format int
+
+const (
+	Uint16 Format = iota
+	Int16
+	Uint32
+	Int32
+	Float32
+)
+
+// words returns how many registers a value of Format f occupies
+func (f Format) words() uint16 {
+	switch f {
+	case Uint32, Int32, Float32:
+		return 2
+	}
+	return 1
+}
+
+// Register describes a value held in one or two Modbus registers and how to present it
+type Register struct {
+	Name     string
+	Unit     byte     // the slave/unit ID of the device
+	Function Function // defaults to ReadHoldingRegisters
+	Address  uint16
+	Format   Format
+	// WordSwap indicates a 32-bit value is sent low word first
+	WordSwap bool
+	// Scale and Offset convert the raw value to engineering units as raw*Scale + Offset; a zero Scale means 1
+	Scale  float64
+	Offset float64
+	// Units is appended to the value in the muster (eg, "V", "kWh")
+	Units string
+	// Precision is the number of decimal places reported; zero means as many as needed, and a negative Precision
+	// rounds to a whole number
+	Precision int
+}
+
+// format renders a value of r to its Precision
+func (r Register) format(v float64) string {
+	switch {
+	case r.Precision == 0:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case r.Precision < 0:
+		return strconv.FormatFloat(v, 'f', 0, 64)
+	}
+	return strconv.FormatFloat(v, 'f', r.Precision, 64)
+}
+
+// function returns the function code used to read r
+func (r Register) function() Function {
+	if r.Function == 0 {
+		return ReadHoldingRegisters
+	}
+	return r.Function
+}
+
+// decode converts raw registers to the scaled value of r
+func (r Register) decode(regs []uint16) float64 {
+	var v float64
+	if r.Format.words() == 1 {
+		if r.Format == Int16 {
+			v = float64(int16(regs[0]))
+		} else {
+			v = float64(regs[0])
+		}
+	} else {
+		hi, lo := regs[0], regs[1]
+		if r.WordSwap {
+			hi, lo = lo, hi
+		}
+		u := uint32(hi)<<16 | uint32(lo)
+		switch r.Format {
+		case Int32:
+			v = float64(int32(u))
+		case Float32:
+			v = float64(math.Float32frombits(u))
+		default:
+			v = float64(u)
+		}
+	}
+	scale := r.Scale
+	if scale == 0 {
+		scale = 1
+	}
+	return v*scale + r.Offset
+}
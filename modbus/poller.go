@@ -0,0 +1,145 @@
+package modbus
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/eyelight/statist"
+)
+
+// Poller reads a set of Registers through a Client and holds the latest value of each
+type Poller struct {
+	client  Client
+	members []*registerStatist
+}
+
+// NewPoller returns a Poller which reads regs through c
+func NewPoller(c Client, regs ...Register) *Poller {
+	p := &Poller{client: c}
+	for _, r := range regs {
+		p.members = append(p.members, &registerStatist{reg: r})
+	}
+	return p
+}
+
+// Lineup returns a Statist for each Register, in the order given to NewPoller
+func (p *Poller) Lineup() statist.Lineup {
+	l := statist.NewLineup()
+	for _, m := range p.members {
+		l = statist.Enlist(m, l)
+	}
+	return l
+}
+
+// ReadOnce reads every Register once, returning the first error encountered;
+// a Register which fails to read keeps reporting its error until a later read succeeds
+func (p *Poller) ReadOnce() error {
+	var first error
+	for _, m := range p.members {
+		r := m.reg
+		regs, err := p.client.ReadRegisters(r.Unit, r.function(), r.Address, r.Format.words())
+		if err != nil && first == nil {
+			first = err
+		}
+		m.update(regs, err)
+	}
+	return first
+}
+
+// ErrInvalidInterval is returned by Poll when its interval is not positive
+var ErrInvalidInterval = errors.New("modbus: poll interval must be positive")
+
+// Poll reads every Register immediately and then at each interval, until ctx is done, and returns ctx.Err()
+func (p *Poller) Poll(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		return ErrInvalidInterval
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		p.ReadOnce()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// registerStatist is the Statist for a single Register
+type registerStatist struct {
+	reg Register
+
+	mu    sync.Mutex
+	value float64
+	at    time.Time // when value was read, or zero if it never has been
+	err   error
+}
+
+// update records the outcome of a read
+func (s *registerStatist) update(regs []uint16, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+	if err == nil {
+		s.value = s.reg.decode(regs)
+		s.at = time.Now()
+	}
+}
+
+// Name returns the Register's Name
+func (s *registerStatist) Name() string {
+	return s.reg.Name
+}
+
+// StateString returns the latest value with its units, eg "Mains: 230.4V"
+func (s *registerStatist) StateString() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case s.err != nil:
+		return s.reg.Name + ": " + string(statist.X()) + " read failed"
+	case s.at.IsZero():
+		return s.reg.Name + ": not yet read"
+	}
+	return s.reg.Name + ": " + s.reg.format(s.value) + s.reg.Units
+}
+
+// State returns the latest value and when it was successfully read, or an empty state and zero time
+// if the Register has not been read, so it is not mistaken for a reading of 0
+func (s *registerStatist) State() (string, time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.at.IsZero() {
+		return "", s.at
+	}
+	return s.reg.format(s.value), s.at
+}
+
+// Value returns the latest value, or NaN if the Register has not been read or its most recent read failed
+func (s *registerStatist) Value() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil || s.at.IsZero() {
+		return math.NaN()
+	}
+	return s.value
+}
+
+// Detail returns the error from the most recent failed read, if any
+func (s *registerStatist) Detail() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err == nil {
+		return ""
+	}
+	return s.err.Error()
+}
+
+// Describe returns the Register's units
+func (s *registerStatist) Describe() statist.Description {
+	return statist.Description{Type: "modbus register", Unit: s.reg.Units}
+}
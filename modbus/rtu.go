@@ -0,0 +1,73 @@
+package modbus
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"sync"
+)
+
+// ErrCRC is returned when an RTU response fails its checksum
+var ErrCRC = errors.New("modbus: crc mismatch")
+
+// RTUClient is a Modbus RTU Client speaking over a serial line the caller has already opened and configured
+// (baud rate, parity, and so on), such as a USB RS-485 adapter
+type RTUClient struct {
+	mu   sync.Mutex
+	port io.ReadWriter
+}
+
+// NewRTUClient returns an RTUClient which exchanges frames over port
+func NewRTUClient(port io.ReadWriter) *RTUClient {
+	return &RTUClient{port: port}
+}
+
+// ReadRegisters reads count registers starting at address from the given unit
+func (c *RTUClient) ReadRegisters(unit byte, fn Function, address, count uint16) ([]uint16, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	frame := append([]byte{unit}, request(fn, address, count)...)
+	crc := crc16(frame)
+	frame = append(frame, byte(crc), byte(crc>>8))
+	if _, err := c.port.Write(frame); err != nil {
+		return nil, err
+	}
+	// unit, function, and either the byte count or an exception code
+	head := make([]byte, 3)
+	if _, err := io.ReadFull(c.port, head); err != nil {
+		return nil, err
+	}
+	rest := 2 // just the crc, for an exception
+	if head[1] == byte(fn) {
+		rest = int(head[2]) + 2
+	}
+	tail := make([]byte, rest)
+	if _, err := io.ReadFull(c.port, tail); err != nil {
+		return nil, err
+	}
+	resp := append(head, tail...)
+	n := len(resp) - 2
+	if crc16(resp[:n]) != binary.LittleEndian.Uint16(resp[n:]) {
+		return nil, ErrCRC
+	}
+	if resp[0] != unit {
+		return nil, ErrMalformed
+	}
+	return response(resp[1:n], fn, count)
+}
+
+// crc16 computes the Modbus RTU checksum of b
+func crc16(b []byte) uint16 {
+	crc := uint16(0xffff)
+	for _, v := range b {
+		crc ^= uint16(v)
+		for i := 0; i < 8; i++ {
+			if crc&1 != 0 {
+				crc = crc>>1 ^ 0xa001
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,88 @@
+package modbus
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// TCPClient is a Modbus TCP Client which connects lazily and reconnects after a failed exchange
+type TCPClient struct {
+	Address string
+	Timeout time.Duration
+
+	mu   sync.Mutex
+	conn net.Conn
+	tid  uint16
+}
+
+// NewTCPClient returns a TCPClient for the device at address (eg, "192.168.1.50:502")
+func NewTCPClient(address string, timeout time.Duration) *TCPClient {
+	return &TCPClient{Address: address, Timeout: timeout}
+}
+
+// ReadRegisters reads count registers starting at address from the given unit
+func (c *TCPClient) ReadRegisters(unit byte, fn Function, address, count uint16) ([]uint16, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	regs, err := c.exchange(unit, fn, address, count)
+	var ex *ExceptionError
+	if err != nil && !errors.As(err, &ex) && c.conn != nil {
+		// the stream may be out of step, so start afresh next time
+		c.conn.Close()
+		c.conn = nil
+	}
+	return regs, err
+}
+
+// exchange sends one request and reads its response; c.mu must be held
+func (c *TCPClient) exchange(unit byte, fn Function, address, count uint16) ([]uint16, error) {
+	if c.conn == nil {
+		conn, err := net.DialTimeout("tcp", c.Address, c.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		c.conn = conn
+	}
+	if c.Timeout > 0 {
+		c.conn.SetDeadline(time.Now().Add(c.Timeout))
+	}
+	c.tid++
+	pdu := request(fn, address, count)
+	frame := make([]byte, 7, 7+len(pdu))
+	binary.BigEndian.PutUint16(frame[0:], c.tid)
+	binary.BigEndian.PutUint16(frame[2:], 0) // protocol ID, always 0 for Modbus
+	binary.BigEndian.PutUint16(frame[4:], uint16(len(pdu)+1))
+	frame[6] = unit
+	if _, err := c.conn.Write(append(frame, pdu...)); err != nil {
+		return nil, err
+	}
+	header := make([]byte, 7)
+	if _, err := io.ReadFull(c.conn, header); err != nil {
+		return nil, err
+	}
+	n := binary.BigEndian.Uint16(header[4:])
+	if binary.BigEndian.Uint16(header[0:]) != c.tid || n < 2 || n > 254 {
+		return nil, ErrMalformed
+	}
+	body := make([]byte, n-1)
+	if _, err := io.ReadFull(c.conn, body); err != nil {
+		return nil, err
+	}
+	return response(body, fn, count)
+}
+
+// Close closes the connection, if one is open; the next read will reconnect
+func (c *TCPClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn == nil {
+		return nil
+	}
+	err := c.conn.Close()
+	c.conn = nil
+	return err
+}
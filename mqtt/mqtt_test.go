@@ -0,0 +1,190 @@
+package mqtt
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"net"
+	"testing"
+	"time"
+)
+
+// fakeBroker is the broker's end of a net.Pipe
+type fakeBroker struct {
+	t    *testing.T
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+// expect reads the next packet and fails the test if it is not of the given type
+func (b *fakeBroker) expect(kind byte) packet {
+	b.t.Helper()
+	b.conn.SetDeadline(time.Now().Add(5 * time.Second))
+	pk, err := readPacket(b.r)
+	if err != nil {
+		b.t.Errorf("broker: reading %#x: %v", kind, err)
+		return packet{}
+	}
+	if pk.header&0xf0 != kind&0xf0 {
+		b.t.Errorf("broker: got packet %#x, want %#x", pk.header, kind)
+	}
+	return pk
+}
+
+// send writes pk to the Publisher
+func (b *fakeBroker) send(pk packet) {
+	b.t.Helper()
+	if err := pk.write(b.conn); err != nil {
+		b.t.Errorf("broker: writing %#x: %v", pk.header, err)
+	}
+}
+
+// accept reads the CONNECT and acknowledges it with code
+func (b *fakeBroker) accept(code byte) packet {
+	b.t.Helper()
+	pk := b.expect(connect)
+	b.send(packet{header: connack, body: []byte{0, code}})
+	return pk
+}
+
+// testPublisher returns a Publisher for c whose connections are served by broker, and a channel closed once broker returns
+func testPublisher(t *testing.T, c Config, broker func(b *fakeBroker)) (*Publisher, chan struct{}) {
+	c.Broker = "tcp://broker.test"
+	p, err := New(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan struct{})
+	p.dial = func() (net.Conn, error) {
+		client, server := net.Pipe()
+		go func() {
+			defer close(done)
+			defer server.Close()
+			broker(&fakeBroker{t: t, conn: server, r: bufio.NewReader(server)})
+		}()
+		return client, nil
+	}
+	return p, done
+}
+
+// parsePublish splits the body of a PUBLISH into its topic, packet identifier and payload
+func parsePublish(pk packet, qos byte) (string, uint16, string) {
+	n := int(binary.BigEndian.Uint16(pk.body))
+	topic, rest := string(pk.body[2:2+n]), pk.body[2+n:]
+	var pid uint16
+	if qos > 0 {
+		pid, rest = binary.BigEndian.Uint16(rest), rest[2:]
+	}
+	return topic, pid, string(rest)
+}
+
+func TestPublish(t *testing.T) {
+	tests := []struct {
+		name   string
+		qos    byte
+		retain bool
+	}{
+		{"qos 0", 0, false},
+		{"qos 0 retained", 0, true},
+		{"qos 1", 1, false},
+		{"qos 2", 2, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, done := testPublisher(t, Config{ClientID: "porch", QoS: tt.qos, Retain: tt.retain, KeepAlive: 30 * time.Second}, func(b *fakeBroker) {
+				c := b.accept(0)
+				if keepAlive := binary.BigEndian.Uint16(c.body[8:]); keepAlive != 30 {
+					t.Errorf("CONNECT keep-alive %d, want 30", keepAlive)
+				}
+				if id := string(c.body[12:]); id != "porch" {
+					t.Errorf("CONNECT client id %q, want porch", id)
+				}
+				pk := b.expect(publish)
+				want := publish | tt.qos<<1
+				if tt.retain {
+					want |= 0x01
+				}
+				if pk.header != want {
+					t.Errorf("PUBLISH header %#x, want %#x", pk.header, want)
+				}
+				topic, pid, payload := parsePublish(pk, tt.qos)
+				if topic != "home/porch" || payload != "Porch light: on" {
+					t.Errorf("published %q to %q", payload, topic)
+				}
+				switch tt.qos {
+				case 1:
+					b.send(packet{header: pingresp})
+					b.send(packet{header: puback, body: appendUint16(nil, pid+1)})
+					b.send(packet{header: puback, body: appendUint16(nil, pid)})
+				case 2:
+					b.send(packet{header: pubrec, body: appendUint16(nil, pid)})
+					rel := b.expect(pubrel)
+					if rel.header != pubrel {
+						t.Errorf("PUBREL header %#x, want %#x", rel.header, pubrel)
+					}
+					if id, _ := rel.packetID(); id != pid {
+						t.Errorf("PUBREL for %d, want %d", id, pid)
+					}
+					b.send(packet{header: pubcomp, body: appendUint16(nil, pid)})
+				}
+				b.expect(disconnect)
+			})
+			if err := p.Publish("home/porch", []byte("Porch light: on")); err != nil {
+				t.Fatal(err)
+			}
+			if err := p.Close(); err != nil {
+				t.Fatal(err)
+			}
+			<-done
+		})
+	}
+}
+
+func TestConnectRefused(t *testing.T) {
+	p, done := testPublisher(t, Config{}, func(b *fakeBroker) {
+		b.accept(5)
+	})
+	err := p.Publish("home/porch", []byte("on"))
+	if err == nil || err.Error() != "mqtt: connection refused: not authorized" {
+		t.Fatalf("got %v, want a refusal", err)
+	}
+	<-done
+}
+
+func TestCallbackWithoutTopic(t *testing.T) {
+	var got error
+	p, _ := testPublisher(t, Config{OnError: func(err error) { got = err }}, func(b *fakeBroker) {
+		t.Error("dialled the broker with no Topic")
+	})
+	p.Callback()("Porch light: on")
+	if !errors.Is(got, errNoTopic) {
+		t.Fatalf("OnError got %v, want %v", got, errNoTopic)
+	}
+}
+
+func TestKeepAlive(t *testing.T) {
+	p, done := testPublisher(t, Config{KeepAlive: time.Second}, func(b *fakeBroker) {
+		b.accept(0)
+		b.expect(publish)
+		b.expect(pingreq)
+		b.send(packet{header: pingresp})
+		b.expect(pingreq)
+		// hang up rather than answer, as a broker which has given up on the connection would
+	})
+	if err := p.Publish("home/porch", []byte("on")); err != nil {
+		t.Fatal(err)
+	}
+	<-done
+	for deadline := time.Now().Add(5 * time.Second); ; {
+		p.mu.Lock()
+		dropped := p.conn == nil
+		p.mu.Unlock()
+		if dropped {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("unanswered ping did not drop the connection")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,120 @@
+package mqtt
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// MQTT 3.1.1 control packet types, as the high nibble of the fixed header
+const (
+	connect    byte = 0x10
+	connack    byte = 0x20
+	publish    byte = 0x30
+	puback     byte = 0x40
+	pubrec     byte = 0x50
+	pubrel     byte = 0x62 // includes the reserved flags the spec requires
+	pubcomp    byte = 0x70
+	pingreq    byte = 0xc0
+	pingresp   byte = 0xd0
+	disconnect byte = 0xe0
+)
+
+// maxRemaining is the largest remaining length MQTT can encode
+const maxRemaining = 268435455
+
+// errMalformed is returned when the broker sends something unparseable
+var errMalformed = errors.New("mqtt: malformed packet from broker")
+
+// packet is a control packet under construction or just read
+type packet struct {
+	header byte
+	body   []byte
+}
+
+// appendString appends an MQTT length-prefixed string
+func appendString(b []byte, s string) []byte {
+	b = append(b, byte(len(s)>>8), byte(len(s)))
+	return append(b, s...)
+}
+
+// appendUint16 appends a big endian 16 bit integer
+func appendUint16(b []byte, v uint16) []byte {
+	return append(b, byte(v>>8), byte(v))
+}
+
+// write sends p with its fixed header
+func (p packet) write(w io.Writer) error {
+	if len(p.body) > maxRemaining {
+		return fmt.Errorf("mqtt: packet of %d bytes is too large", len(p.body))
+	}
+	b := make([]byte, 0, 5+len(p.body))
+	b = append(b, p.header)
+	n := len(p.body)
+	for {
+		d := byte(n % 128)
+		n /= 128
+		if n > 0 {
+			d |= 0x80
+		}
+		b = append(b, d)
+		if n == 0 {
+			break
+		}
+	}
+	_, err := w.Write(append(b, p.body...))
+	return err
+}
+
+// readPacket reads one control packet
+func readPacket(r *bufio.Reader) (packet, error) {
+	h, err := r.ReadByte()
+	if err != nil {
+		return packet{}, err
+	}
+	n, mult := 0, 1
+	for i := 0; ; i++ {
+		d, err := r.ReadByte()
+		if err != nil {
+			return packet{}, err
+		}
+		n += int(d&0x7f) * mult
+		if d&0x80 == 0 {
+			break
+		}
+		if i == 3 {
+			return packet{}, errMalformed
+		}
+		mult *= 128
+	}
+	body := make([]byte, n)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return packet{}, err
+	}
+	return packet{header: h, body: body}, nil
+}
+
+// packetID returns the packet identifier at the start of an acknowledgement's body
+func (p packet) packetID() (uint16, error) {
+	if len(p.body) < 2 {
+		return 0, errMalformed
+	}
+	return binary.BigEndian.Uint16(p.body), nil
+}
+
+// connectError describes a refused connection by its CONNACK return code
+func connectError(code byte) error {
+	reasons := map[byte]string{
+		1: "unacceptable protocol version",
+		2: "identifier rejected",
+		3: "server unavailable",
+		4: "bad user name or password",
+		5: "not authorized",
+	}
+	if r, ok := reasons[code]; ok {
+		return errors.New("mqtt: connection refused: " + r)
+	}
+	return fmt.Errorf("mqtt: connection refused with code %d", code)
+}
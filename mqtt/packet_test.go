@@ -0,0 +1,72 @@
+package mqtt
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestRemainingLength(t *testing.T) {
+	tests := []struct {
+		n       int
+		encoded []byte
+	}{
+		{0, []byte{0x00}},
+		{127, []byte{0x7f}},
+		{128, []byte{0x80, 0x01}},
+		{16383, []byte{0xff, 0x7f}},
+		{16384, []byte{0x80, 0x80, 0x01}},
+		{2097151, []byte{0xff, 0xff, 0x7f}},
+		{2097152, []byte{0x80, 0x80, 0x80, 0x01}},
+	}
+	for _, tt := range tests {
+		var b bytes.Buffer
+		body := bytes.Repeat([]byte{'x'}, tt.n)
+		if err := (packet{header: publish, body: body}).write(&b); err != nil {
+			t.Fatalf("%d: write: %v", tt.n, err)
+		}
+		if got := b.Bytes()[1 : 1+len(tt.encoded)]; !bytes.Equal(got, tt.encoded) {
+			t.Errorf("%d: remaining length encoded as % x, want % x", tt.n, got, tt.encoded)
+		}
+		pk, err := readPacket(bufio.NewReader(&b))
+		if err != nil {
+			t.Fatalf("%d: read: %v", tt.n, err)
+		}
+		if pk.header != publish || len(pk.body) != tt.n {
+			t.Errorf("%d: read back header %#x with %d bytes", tt.n, pk.header, len(pk.body))
+		}
+	}
+}
+
+func TestReadPacket(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []byte
+		want packet
+		err  error
+	}{
+		{"connack", []byte{connack, 2, 0, 0}, packet{header: connack, body: []byte{0, 0}}, nil},
+		{"puback", []byte{puback, 2, 0x12, 0x34}, packet{header: puback, body: []byte{0x12, 0x34}}, nil},
+		{"pingresp", []byte{pingresp, 0}, packet{header: pingresp, body: []byte{}}, nil},
+		{"five length bytes", []byte{publish, 0x80, 0x80, 0x80, 0x80, 0x01}, packet{}, errMalformed},
+		{"short body", []byte{puback, 2, 0x12}, packet{}, errors.New("unexpected EOF")},
+		{"no length", []byte{puback}, packet{}, errors.New("EOF")},
+	}
+	for _, tt := range tests {
+		pk, err := readPacket(bufio.NewReader(bytes.NewReader(tt.in)))
+		if tt.err != nil {
+			if err == nil || err.Error() != tt.err.Error() {
+				t.Errorf("%s: got error %v, want %v", tt.name, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if pk.header != tt.want.header || !bytes.Equal(pk.body, tt.want.body) {
+			t.Errorf("%s: got %#x % x, want %#x % x", tt.name, pk.header, pk.body, tt.want.header, tt.want.body)
+		}
+	}
+}
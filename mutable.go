@@ -0,0 +1,102 @@
+package statist
+
+import (
+	"sync"
+	"time"
+)
+
+// MutableStatist is a Statist whose state may be set from outside (eg, by a script or a remote update)
+type MutableStatist interface {
+	Statist
+	SetState(state string) error
+}
+
+// Mutable is a MutableStatist holding a single state string, which announces each change to its watchers
+type Mutable struct {
+	name   string
+	states []string
+
+	mu       sync.Mutex
+	state    string
+	since    time.Time
+	watchers map[int]func(StateEvent)
+	next     int
+}
+
+// NewMutable returns a Mutable called name, starting in state; if any states are given, they are the only ones
+// SetState accepts (the starting state is taken as given)
+func NewMutable(name, state string, states ...string) *Mutable {
+	return NewMutableAt(name, state, time.Now(), states...)
+}
+
+// NewMutableAt does the same as NewMutable for a starting state which was set at since (eg, one restored from a Snapshot)
+func NewMutableAt(name, state string, since time.Time, states ...string) *Mutable {
+	return &Mutable{
+		name:     name,
+		states:   append([]string(nil), states...),
+		state:    state,
+		since:    since,
+		watchers: make(map[int]func(StateEvent)),
+	}
+}
+
+// Name returns the name given to NewMutable
+func (m *Mutable) Name() string {
+	return m.name
+}
+
+// StateString returns the name and current state, eg "Porch light: on"
+func (m *Mutable) StateString() string {
+	s, _ := m.State()
+	return m.name + ": " + s
+}
+
+// State returns the current state and when it was set
+func (m *Mutable) State() (string, time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.state, m.since
+}
+
+// States returns the vocabulary given to NewMutable, which is empty if any state is allowed
+func (m *Mutable) States() []string {
+	return append([]string(nil), m.states...)
+}
+
+// SetState changes the state, notifying watchers if it differs from the current one,
+// or returns an error wrapping ErrInvalidState if the state is outside the Mutable's vocabulary
+func (m *Mutable) SetState(state string) error {
+	if err := ValidState(m, state); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	if state == m.state {
+		m.mu.Unlock()
+		return nil
+	}
+	e := StateEvent{Name: m.name, Old: m.state, New: state, Time: time.Now()}
+	m.state, m.since = state, e.Time
+	watchers := make([]func(StateEvent), 0, len(m.watchers))
+	for _, fn := range m.watchers {
+		watchers = append(watchers, fn)
+	}
+	m.mu.Unlock()
+	for _, fn := range watchers {
+		fn(e)
+	}
+	return nil
+}
+
+// Watch calls fn with every change of state until the returned function is called
+func (m *Mutable) Watch(fn func(StateEvent)) func() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id := m.next
+	m.next++
+	m.watchers[id] = fn
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		delete(m.watchers, id)
+	}
+}
@@ -0,0 +1,65 @@
+package statist
+
+import (
+	"strings"
+)
+
+// NamedLineup is a Lineup with a name, which is itself a Statist so that it may be Enlisted into a parent Lineup
+// (eg, a lineup per room within a lineup for the house); like a Lineup, it is not safe for concurrent mutation
+type NamedLineup struct {
+	name   string
+	lineup Lineup
+}
+
+// NewNamedLineup returns a NamedLineup called name holding the members of l
+func NewNamedLineup(name string, l Lineup) *NamedLineup {
+	return &NamedLineup{name: name, lineup: l}
+}
+
+// Name returns the name given to NewNamedLineup
+func (n *NamedLineup) Name() string {
+	return n.name
+}
+
+// Lineup returns the members
+func (n *NamedLineup) Lineup() Lineup {
+	return n.lineup
+}
+
+// Enlist pushes a Statist into the NamedLineup
+func (n *NamedLineup) Enlist(s Statist) {
+	n.lineup = Enlist(s, n.lineup)
+}
+
+// Desert removes a Statist (by 'IDOf()') from the NamedLineup, with the same caveats as the Desert function
+func (n *NamedLineup) Desert(s Statist) {
+	n.lineup = Desert(s, n.lineup)
+}
+
+// StateString returns the name followed by the Muster of the members, each line indented by a tab,
+// so that nested lineups indent further at each level
+func (n *NamedLineup) StateString() string {
+	b := strings.Builder{}
+	b.WriteString(n.name)
+	b.WriteByte(':')
+	m := strings.TrimSuffix(n.lineup.Muster(), string(NewLine()))
+	if m == "" {
+		return b.String()
+	}
+	for _, line := range strings.Split(m, string(NewLine())) {
+		b.WriteByte(NewLine())
+		b.WriteByte(Tab())
+		b.WriteString(line)
+	}
+	return b.String()
+}
+
+// Level returns the Worst level of the members
+func (n *NamedLineup) Level() Level {
+	return n.lineup.Worst()
+}
+
+// MarshalJSON returns the MusterJSON of the members, so nested lineups nest in JSON too
+func (n *NamedLineup) MarshalJSON() ([]byte, error) {
+	return n.lineup.MusterJSON()
+}
@@ -0,0 +1,57 @@
+package statist
+
+import (
+	"sort"
+)
+
+// Prioritized may be implemented by a Statist which must be reported ahead of others (eg, mains power, water leak);
+// members with a higher Priority() appear first in every muster, and members without one are treated as priority 0
+type Prioritized interface {
+	Priority() int
+}
+
+// ByPriority returns a copy of the Lineup sorted by descending Priority(), keeping enlistment order among equals
+func (l Lineup) ByPriority() Lineup {
+	o := make(Lineup, 0, len(l))
+	for _, i := range l.display() {
+		o = append(o, l[i])
+	}
+	return o
+}
+
+// SortBy returns a copy of the Lineup sorted by less, keeping enlistment order among equals;
+// a muster of the copy still puts members with a higher Priority() first, with this order among each priority
+func (l Lineup) SortBy(less func(a, b Statist) bool) Lineup {
+	o := append(make(Lineup, 0, len(l)), l...)
+	sort.SliceStable(o, func(a, b int) bool {
+		return less(o[a], o[b])
+	})
+	return o
+}
+
+// SortByName returns a copy of the Lineup sorted by Name(), as SortBy does
+func (l Lineup) SortByName() Lineup {
+	return l.SortBy(func(a, b Statist) bool {
+		return a.Name() < b.Name()
+	})
+}
+
+// display returns the indices of the Lineup in the order they should be rendered
+func (l Lineup) display() []int {
+	idx := make([]int, len(l))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool {
+		return priority(l[idx[a]]) > priority(l[idx[b]])
+	})
+	return idx
+}
+
+// priority returns the Priority() of s, or 0 if s is not Prioritized
+func priority(s Statist) int {
+	if p, ok := AsPrioritized(s); ok {
+		return p.Priority()
+	}
+	return 0
+}
@@ -0,0 +1,87 @@
+package statist
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Numeric may be implemented by a Statist whose state is a measurement rather than free text
+type Numeric interface {
+	Value() float64
+}
+
+// sample is a single exposition line waiting to be written under its metric family
+type sample struct {
+	labels string
+	value  float64
+}
+
+// MusterPrometheus renders the Lineup in the Prometheus text exposition format: the Value of each Numeric
+// as the gauge statist_value, the StateString of every other member as statist_state (always 1, with the state
+// as a label), the Level of each Leveled as statist_level, and the refresh time of each TimedStatist as
+// statist_since_seconds; every sample is labelled with the member's id and name.
+// Members with unmet dependencies are omitted
+func (l Lineup) MusterPrometheus() string {
+	var values, states, levels, since []sample
+	l.evaluate(func(i int, unmet []string) {
+		if len(unmet) > 0 {
+			return
+		}
+		v := l[i]
+		labels := `id="` + escapeLabel(IDOf(v)) + `",name="` + escapeLabel(v.Name()) + `"`
+		if n, ok := AsNumeric(v); ok {
+			values = append(values, sample{labels, n.Value()})
+		} else {
+			states = append(states, sample{labels + `,state="` + escapeLabel(v.StateString()) + `"`, 1})
+		}
+		if lv, ok := AsLeveled(v); ok {
+			levels = append(levels, sample{labels, float64(lv.Level())})
+		}
+		if t, ok := AsTimed(v); ok {
+			if _, at := t.State(); !at.IsZero() {
+				since = append(since, sample{labels, float64(at.UnixNano()) / 1e9})
+			}
+		}
+	})
+	s := strings.Builder{}
+	s.Grow(1024)
+	writeFamily(&s, "statist_value", "The value of each numeric Statist.", values)
+	writeFamily(&s, "statist_state", "The state of each non-numeric Statist, as a label.", states)
+	writeFamily(&s, "statist_level", "The health of each Statist; 0 OK, 1 unknown, 2 warn, 3 error.", levels)
+	writeFamily(&s, "statist_since_seconds", "When each Statist's state was last refreshed, in seconds since the epoch.", since)
+	return s.String()
+}
+
+// writeFamily writes the HELP and TYPE lines of a gauge followed by its samples, if there are any
+func writeFamily(s *strings.Builder, name, help string, samples []sample) {
+	if len(samples) == 0 {
+		return
+	}
+	s.WriteString("# HELP " + name + " " + help)
+	s.WriteByte(NewLine())
+	s.WriteString("# TYPE " + name + " gauge")
+	s.WriteByte(NewLine())
+	for _, v := range samples {
+		s.WriteString(name + "{" + v.labels + "} " + formatSample(v.value))
+		s.WriteByte(NewLine())
+	}
+}
+
+// formatSample formats a sample value, spelling infinities and NaN the way the exposition format expects
+func formatSample(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// escapeLabel escapes a label value for the exposition format
+func escapeLabel(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(v)
+}
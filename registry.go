@@ -0,0 +1,128 @@
+package statist
+
+import (
+	"sync"
+)
+
+// Registry is a Lineup guarded by a mutex, so members may be enlisted and deserted from any goroutine
+// (eg, as sensors are hot-plugged) while a reporting loop musters it
+type Registry struct {
+	mu      sync.RWMutex
+	lineup  Lineup
+	cancels []func() // cancels the Watch of the member at the same index, or nil if it is not Watchable
+
+	subMu  sync.Mutex
+	subs   map[*subscription]struct{}
+	alerts map[*Alerts]struct{}
+}
+
+// NewRegistry creates an empty Registry and returns it
+func NewRegistry() *Registry {
+	return &Registry{lineup: NewLineup()}
+}
+
+// Enlist pushes a Statist into the Registry; the state changes of a Watchable are relayed to subscribers
+func (r *Registry) Enlist(s Statist) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lineup = Enlist(s, r.lineup)
+	r.cancels = append(r.cancels, r.watch(s))
+}
+
+// EnlistUnique pushes a Statist into the Registry as Enlist does,
+// or returns an error wrapping ErrDuplicate if a member already has its Name() or IDOf
+func (r *Registry) EnlistUnique(s Statist) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, err := EnlistUnique(s, r.lineup)
+	if err != nil {
+		return err
+	}
+	r.lineup = l
+	r.cancels = append(r.cancels, r.watch(s))
+	return nil
+}
+
+// EnlistOrReplace swaps a Statist into the place of the first member with the same Name() or IDOf,
+// or enlists it if there is none; the watch of a replaced Watchable is cancelled
+func (r *Registry) EnlistOrReplace(s Statist) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	i := r.lineup.same(s)
+	if i < 0 {
+		r.lineup = Enlist(s, r.lineup)
+		r.cancels = append(r.cancels, r.watch(s))
+		return
+	}
+	unwatch(r.cancels[i])
+	r.lineup[i], r.cancels[i] = s, r.watch(s)
+}
+
+// Desert removes a Statist (by 'IDOf()') from the Registry, with the same caveats as the Desert function
+func (r *Registry) Desert(s Statist) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id := IDOf(s)
+	for i, v := range r.lineup {
+		if IDOf(v) == id {
+			unwatch(r.cancels[i])
+			r.lineup = append(r.lineup[:i], r.lineup[i+1:]...)
+			r.cancels = append(r.cancels[:i], r.cancels[i+1:]...)
+			return
+		}
+	}
+}
+
+// watch relays the state changes of s to subscribers if it is Watchable, returning the function which stops it,
+// or nil if s is not Watchable; r.mu must be held
+func (r *Registry) watch(s Statist) func() {
+	if w, ok := AsWatchable(s); ok {
+		return w.Watch(r.publish)
+	}
+	return nil
+}
+
+// unwatch calls cancel, the result of watch, unless it is nil
+func unwatch(cancel func()) {
+	if cancel != nil {
+		cancel()
+	}
+}
+
+// Get returns the first member whose Name() is name, or false if there is none
+func (r *Registry) Get(name string) (Statist, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.lineup.Find(name)
+}
+
+// Len returns the number of members in the Registry
+func (r *Registry) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.lineup)
+}
+
+// Lineup returns a copy of the Registry's members, safe to use while the Registry changes
+func (r *Registry) Lineup() Lineup {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append(NewLineup(), r.lineup...)
+}
+
+// Muster returns the Muster of the Registry's current members, testing the states it reads against any attached Alerts;
+// members are collected under the lock but mustered outside it, so a slow Statist does not hold up Enlist or Desert
+func (r *Registry) Muster() string {
+	return r.musterAlerts(nil)
+}
+
+// MusterWithGreeting returns the MusterWithGreeting of the Registry's current members, testing any attached Alerts
+func (r *Registry) MusterWithGreeting(g string) string {
+	return r.musterAlerts(&g)
+}
+
+// musterAlerts musters the Registry's current members after the greeting g, if there is one,
+// testing them against alerts as well as any attached Alerts
+func (r *Registry) musterAlerts(g *string, alerts ...*Alerts) string {
+	return r.Lineup().muster(g, append(r.attached(), alerts...))
+}
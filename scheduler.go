@@ -0,0 +1,119 @@
+package statist
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrSchedulerRunning is returned when starting a Scheduler which is already running
+var ErrSchedulerRunning = errors.New("statist: scheduler already running")
+
+// ErrInvalidInterval is returned when starting a Scheduler whose interval is not positive
+var ErrInvalidInterval = errors.New("statist: scheduler interval must be positive")
+
+// ErrAlertsUnsupported is returned when starting a Scheduler with Alerts over a Musterer which cannot test them
+var ErrAlertsUnsupported = errors.New("statist: scheduler Musterer cannot test Alerts")
+
+// alertMusterer is a Musterer which can test the states its muster reads against Alerts
+// (a Lineup, Registry, or Differ)
+type alertMusterer interface {
+	musterAlerts(g *string, alerts ...*Alerts) string
+}
+
+// Scheduler periodically musters a Musterer (eg, a Lineup or a Registry) and hands the result to a callback
+type Scheduler struct {
+	m        Musterer
+	interval time.Duration
+	fn       func(string)
+
+	// Greeting, if set, is called with the time of each tick and the muster is taken with MusterWithGreeting
+	Greeting func(time.Time) string
+
+	// Alerts, if set, are tested against the states read by each muster, along with any Alerts attached to a Registry;
+	// the Musterer must be a Lineup, Registry, or Differ, or Start returns ErrAlertsUnsupported
+	Alerts *Alerts
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewScheduler returns a Scheduler which, once started, musters m every interval and calls fn with the result
+func NewScheduler(m Musterer, interval time.Duration, fn func(string)) *Scheduler {
+	return &Scheduler{m: m, interval: interval, fn: fn}
+}
+
+// Start begins mustering on a ticker in its own goroutine, until Stop is called or ctx is cancelled
+func (s *Scheduler) Start(ctx context.Context) error {
+	if s.interval <= 0 {
+		return ErrInvalidInterval
+	}
+	if _, ok := s.m.(alertMusterer); s.Alerts != nil && !ok {
+		return ErrAlertsUnsupported
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.done != nil {
+		select {
+		case <-s.done:
+		default:
+			return ErrSchedulerRunning
+		}
+	}
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+	go s.run(ctx, s.done)
+	return nil
+}
+
+// Stop halts the Scheduler and waits for any callback in progress to return; it is safe to call when not running
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	cancel, done := s.cancel, s.done
+	s.mu.Unlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+// Done returns a channel which is closed once the Scheduler stops, or nil if it was never started
+func (s *Scheduler) Done() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.done
+}
+
+// run calls the callback with a muster on every tick until ctx is done
+func (s *Scheduler) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+	t := time.NewTicker(s.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-t.C:
+			s.fn(s.muster(now))
+		}
+	}
+}
+
+// muster takes the muster for a tick at now, testing the Scheduler's Alerts if the Musterer supports them
+func (s *Scheduler) muster(now time.Time) string {
+	var g *string
+	if s.Greeting != nil {
+		greeting := s.Greeting(now)
+		g = &greeting
+	}
+	if m, ok := s.m.(alertMusterer); ok && s.Alerts != nil {
+		return m.musterAlerts(g, s.Alerts)
+	}
+	if g != nil {
+		return s.m.MusterWithGreeting(*g)
+	}
+	return s.m.Muster()
+}
@@ -0,0 +1,79 @@
+package sensors
+
+import (
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/eyelight/statist"
+)
+
+// GPIOClass is where the kernel's sysfs GPIO interface exposes exported pins
+const GPIOClass = "/sys/class/gpio"
+
+// Contact states reported by a Contact
+const (
+	Open   = "open"
+	Closed = "closed"
+)
+
+// Contact is a Statist for a simple contact input (eg, a reed switch on a door) wired to a GPIO pin;
+// the pin must already be exported and configured as an input
+type Contact struct {
+	name string
+	Path string // the pin's value file
+	// ActiveLow reports the contact as Closed when the pin reads low, as when it is wired to ground with a pull-up
+	ActiveLow bool
+
+	mu      sync.Mutex
+	lastErr error
+}
+
+// NewContact returns a Contact called name for the given (BCM-numbered) GPIO pin, which reads Closed when high
+func NewContact(name string, pin int) *Contact {
+	return &Contact{
+		name: name,
+		Path: filepath.Join(GPIOClass, "gpio"+strconv.Itoa(pin), "value"),
+	}
+}
+
+// Name returns the name given to NewContact
+func (c *Contact) Name() string {
+	return c.name
+}
+
+// StateString returns whether the contact is open or closed, eg "Back door: open"
+func (c *Contact) StateString() string {
+	s, err := c.Read()
+	if err != nil {
+		return c.name + ": " + string(statist.X()) + " read failed"
+	}
+	return c.name + ": " + s
+}
+
+// Read reads the pin and returns Open or Closed
+func (c *Contact) Read() (string, error) {
+	v, err := readInt(c.Path)
+	c.mu.Lock()
+	c.lastErr = err
+	c.mu.Unlock()
+	if err != nil {
+		return "", err
+	}
+	if (v != 0) != c.ActiveLow {
+		return Closed, nil
+	}
+	return Open, nil
+}
+
+// States returns the vocabulary of a Contact
+func (c *Contact) States() []string {
+	return []string{Open, Closed}
+}
+
+// Detail returns the error from the most recent failed read, if any
+func (c *Contact) Detail() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return errText(c.lastErr)
+}
@@ -0,0 +1,87 @@
+package sensors
+
+import (
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/eyelight/statist"
+)
+
+// IIODevices is where the kernel exposes industrial I/O devices, including the dht11 driver (which also drives DHT22s)
+const IIODevices = "/sys/bus/iio/devices"
+
+// dhtRetries is how many times a DHT22 read is attempted; the sensor's timing is strict and reads often fail
+const dhtRetries = 3
+
+// DHT22 is a Statist for a DHT22 (AM2302) temperature and humidity sensor, bound to the kernel's dht11 driver
+// (eg, with "dtoverlay=dht11,gpiopin=4" in config.txt)
+type DHT22 struct {
+	name string
+	Path string // the IIO device directory
+
+	mu      sync.Mutex
+	lastErr error
+}
+
+// NewDHT22 returns a DHT22 called name for the IIO device with the given index (ie, /sys/bus/iio/devices/iio:device0)
+func NewDHT22(name string, device int) *DHT22 {
+	return &DHT22{
+		name: name,
+		Path: filepath.Join(IIODevices, "iio:device"+strconv.Itoa(device)),
+	}
+}
+
+// Name returns the name given to NewDHT22
+func (d *DHT22) Name() string {
+	return d.name
+}
+
+// StateString returns the current temperature and humidity, eg "Garage: 21.3°C 48.2%"
+func (d *DHT22) StateString() string {
+	t, h, err := d.Read()
+	if err != nil {
+		return d.name + ": " + string(statist.X()) + " read failed"
+	}
+	return d.name + ": " + strconv.FormatFloat(t, 'f', 1, 64) + "°C " + strconv.FormatFloat(h, 'f', 1, 64) + "%"
+}
+
+// Read reads the sensor, retrying transient failures, and returns degrees Celsius and percent relative humidity
+func (d *DHT22) Read() (celsius, humidity float64, err error) {
+	for i := 0; i < dhtRetries; i++ {
+		if i > 0 {
+			// the sensor needs a moment between conversions
+			time.Sleep(2 * time.Second)
+		}
+		var t, h int
+		if t, err = readInt(filepath.Join(d.Path, "in_temp_input")); err != nil {
+			continue
+		}
+		if h, err = readInt(filepath.Join(d.Path, "in_humidityrelative_input")); err != nil {
+			continue
+		}
+		celsius, humidity = float64(t)/1000, float64(h)/1000
+		break
+	}
+	d.mu.Lock()
+	d.lastErr = err
+	d.mu.Unlock()
+	return celsius, humidity, err
+}
+
+// Detail returns the error from the most recent failed read, if any
+func (d *DHT22) Detail() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return errText(d.lastErr)
+}
+
+// Describe returns the sensor's metadata
+func (d *DHT22) Describe() statist.Description {
+	return statist.Description{
+		Type:         "temperature/humidity",
+		Unit:         "°C/%RH",
+		Manufacturer: "Aosong",
+	}
+}
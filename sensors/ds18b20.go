@@ -0,0 +1,99 @@
+package sensors
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/eyelight/statist"
+)
+
+// W1Devices is where the kernel's w1-gpio driver exposes 1-wire devices
+const W1Devices = "/sys/bus/w1/devices"
+
+// DS18B20 is a Statist for a DS18B20 1-wire temperature sensor
+type DS18B20 struct {
+	name string
+	Path string // the device's w1_slave file
+
+	mu      sync.Mutex
+	lastErr error
+}
+
+// NewDS18B20 returns a DS18B20 called name for the 1-wire device with the given id (eg, "28-00000a1b2c3d")
+func NewDS18B20(name, id string) *DS18B20 {
+	return &DS18B20{
+		name: name,
+		Path: filepath.Join(W1Devices, id, "w1_slave"),
+	}
+}
+
+// Name returns the name given to NewDS18B20
+func (d *DS18B20) Name() string {
+	return d.name
+}
+
+// StateString returns the current temperature, eg "Attic: 23.125°C"
+func (d *DS18B20) StateString() string {
+	t, err := d.Celsius()
+	if err != nil {
+		return d.name + ": " + string(statist.X()) + " read failed"
+	}
+	return d.name + ": " + strconv.FormatFloat(t, 'f', -1, 64) + "°C"
+}
+
+// Celsius reads the sensor and returns its temperature in degrees Celsius
+func (d *DS18B20) Celsius() (float64, error) {
+	t, err := readW1Slave(d.Path)
+	d.mu.Lock()
+	d.lastErr = err
+	d.mu.Unlock()
+	return t, err
+}
+
+// Detail returns the error from the most recent failed read, if any
+func (d *DS18B20) Detail() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return errText(d.lastErr)
+}
+
+// Describe returns the sensor's metadata
+func (d *DS18B20) Describe() statist.Description {
+	return statist.Description{
+		Type:         "temperature",
+		Unit:         "°C",
+		Manufacturer: "Maxim Integrated",
+		URL:          "https://www.analog.com/en/products/ds18b20.html",
+	}
+}
+
+// readW1Slave parses a w1_slave file, which looks like
+//
+//	72 01 4b 46 7f ff 0e 10 57 : crc=57 YES
+//	72 01 4b 46 7f ff 0e 10 57 t=23125
+func readW1Slave(path string) (float64, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	if len(lines) != 2 {
+		return 0, errors.New("sensors: malformed w1_slave output from " + path)
+	}
+	if !strings.HasSuffix(strings.TrimSpace(lines[0]), "YES") {
+		return 0, errors.New("sensors: crc check failed reading " + path)
+	}
+	i := strings.LastIndex(lines[1], "t=")
+	if i < 0 {
+		return 0, errors.New("sensors: no temperature in w1_slave output from " + path)
+	}
+	milli, err := strconv.Atoi(strings.TrimSpace(lines[1][i+2:]))
+	if err != nil {
+		return 0, err
+	}
+	return float64(milli) / 1000, nil
+}
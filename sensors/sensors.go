@@ -0,0 +1,29 @@
+// package sensors provides Statists for common Raspberry Pi sensors, read through the Linux kernel's sysfs drivers
+// so that no cgo or third-party hardware library is needed.
+//
+// Each sensor is read afresh whenever its StateString is called; a failed read is reported in the muster line
+// and the underlying error is available from Detail().
+package sensors
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// readInt reads a file holding a single integer, as the kernel's sysfs attributes commonly do
+func readInt(path string) (int, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(string(b)))
+}
+
+// errText returns the text of err, or an empty string if err is nil
+func errText(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,190 @@
+// package serialline turns the newline-delimited output of a serial device (eg, an Arduino printing "TEMP=21.3")
+// into Statists.
+//
+// Open and configure the port however suits the platform, then hand it to a Reader as an io.Reader;
+// each key parsed from the stream becomes a Statist reporting the key's most recent value.
+package serialline
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/eyelight/statist"
+)
+
+// KeyValue matches pairs like "TEMP=21.3" or "hum: 40", any number to a line
+var KeyValue = regexp.MustCompile(`(?P<key>\w+)\s*[=:]\s*(?P<value>[^\s,;]+)`)
+
+// DefaultTemplate renders a Value as "name: value"
+var DefaultTemplate = template.Must(template.New("serialline").Parse("{{.Name}}: {{.Value}}"))
+
+// Config controls how a Reader parses lines and names and renders what it finds
+type Config struct {
+	// Pattern finds keys and values in each line using the named groups "key" and "value"; it defaults to KeyValue
+	Pattern *regexp.Regexp
+	// Names maps keys to Statist names; unmapped keys are named after themselves
+	Names map[string]string
+	// Strict ignores keys which are not in Names
+	Strict bool
+	// Template renders each Value's StateString and is executed with the Value; it defaults to DefaultTemplate
+	Template *template.Template
+	// OnEnlist, if set, is called when a key is seen for the first time
+	OnEnlist func(*Value)
+}
+
+// Reader parses lines from a serial device into Values
+type Reader struct {
+	r     io.Reader
+	cfg   Config
+	key   int // index of the key group in cfg.Pattern
+	value int // index of the value group in cfg.Pattern
+
+	mu     sync.Mutex
+	lineup statist.Lineup
+	values map[string]*Value
+}
+
+// NewReader returns a Reader parsing lines from r according to c
+func NewReader(r io.Reader, c Config) (*Reader, error) {
+	if c.Pattern == nil {
+		c.Pattern = KeyValue
+	}
+	if c.Template == nil {
+		c.Template = DefaultTemplate
+	}
+	rd := &Reader{
+		r:      r,
+		cfg:    c,
+		key:    c.Pattern.SubexpIndex("key"),
+		value:  c.Pattern.SubexpIndex("value"),
+		lineup: statist.NewLineup(),
+		values: make(map[string]*Value),
+	}
+	if rd.key < 0 || rd.value < 0 {
+		return nil, errors.New(`serialline: Pattern needs groups named "key" and "value"`)
+	}
+	return rd, nil
+}
+
+// Run parses lines until the underlying reader returns EOF (nil is returned) or fails (its error is returned);
+// close the port to make Run return
+func (rd *Reader) Run() error {
+	sc := bufio.NewScanner(rd.r)
+	for sc.Scan() {
+		rd.Parse(sc.Text())
+	}
+	return sc.Err()
+}
+
+// Parse updates a Value for each key found in line, and returns how many were found
+func (rd *Reader) Parse(line string) int {
+	now := time.Now()
+	n := 0
+	for _, m := range rd.cfg.Pattern.FindAllStringSubmatch(strings.TrimSpace(line), -1) {
+		if rd.update(m[rd.key], m[rd.value], now) {
+			n++
+		}
+	}
+	return n
+}
+
+// update records a value for key, enlisting a new Value if key has not been seen before
+func (rd *Reader) update(key, value string, now time.Time) bool {
+	name, named := rd.cfg.Names[key]
+	if !named {
+		if rd.cfg.Strict {
+			return false
+		}
+		name = key
+	}
+	rd.mu.Lock()
+	v, seen := rd.values[key]
+	if !seen {
+		v = &Value{key: key, name: name, tmpl: rd.cfg.Template}
+		rd.values[key] = v
+		rd.lineup = statist.Enlist(v, rd.lineup)
+	}
+	rd.mu.Unlock()
+	v.set(value, now)
+	if !seen && rd.cfg.OnEnlist != nil {
+		rd.cfg.OnEnlist(v)
+	}
+	return true
+}
+
+// Lineup returns a Statist for each key seen so far, in the order they first appeared
+func (rd *Reader) Lineup() statist.Lineup {
+	rd.mu.Lock()
+	defer rd.mu.Unlock()
+	return append(statist.NewLineup(), rd.lineup...)
+}
+
+// Value is the Statist for a single key
+type Value struct {
+	key  string
+	name string
+	tmpl *template.Template
+
+	mu      sync.Mutex
+	value   string
+	updated time.Time
+}
+
+// set records the latest value
+func (v *Value) set(value string, now time.Time) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.value = value
+	v.updated = now
+}
+
+// ID returns the key, which stays put if the Value is renamed
+func (v *Value) ID() string {
+	return v.key
+}
+
+// Name returns the name mapped to the key, or the key
+func (v *Value) Name() string {
+	return v.name
+}
+
+// Key returns the key as it appears in the device's output
+func (v *Value) Key() string {
+	return v.key
+}
+
+// Value returns the most recently parsed value
+func (v *Value) Value() string {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.value
+}
+
+// Updated returns when the value was last parsed
+func (v *Value) Updated() time.Time {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.updated
+}
+
+// State returns the most recently parsed value and when it was parsed
+func (v *Value) State() (string, time.Time) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.value, v.updated
+}
+
+// StateString renders the Value with the configured template
+func (v *Value) StateString() string {
+	s := strings.Builder{}
+	if err := v.tmpl.Execute(&s, v); err != nil {
+		return v.name + ": " + string(statist.X()) + " " + err.Error()
+	}
+	return s.String()
+}
@@ -0,0 +1,64 @@
+package statist
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// SnapshotRecord is the last known state of a single member, as written by Snapshot
+type SnapshotRecord struct {
+	ID    string    `json:"id"` // the member's IDOf, which is stable across renames
+	Name  string    `json:"name"`
+	State string    `json:"state"`
+	Time  time.Time `json:"time"`
+}
+
+// Snapshot writes the last known state of each member to w as JSON, in enlistment order,
+// so it can be restored with RestoreLineup; the raw State of a TimedStatist is saved along with its timestamp,
+// and the StateString of any other member, less a leading "Name: ", with the time it was read
+func (l Lineup) Snapshot(w io.Writer) error {
+	records := make([]SnapshotRecord, len(l))
+	for i, v := range l {
+		r := SnapshotRecord{ID: IDOf(v), Name: v.Name()}
+		if t, ok := AsTimed(v); ok {
+			r.State, r.Time = t.State()
+		} else {
+			r.State, r.Time = stateOf(v), time.Now()
+		}
+		records[i] = r
+	}
+	return json.NewEncoder(w).Encode(records)
+}
+
+// ReadSnapshot reads the records of a Snapshot from r, in the order they were saved;
+// use it in place of RestoreLineup to match records to members by ID (eg, a sensor renamed since the snapshot)
+func ReadSnapshot(r io.Reader) ([]SnapshotRecord, error) {
+	var records []SnapshotRecord
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return nil, fmt.Errorf("statist: reading snapshot: %w", err)
+	}
+	return records, nil
+}
+
+// RestoreLineup reads a Snapshot from r and returns a Lineup of the Statists made by factory from each saved member, in the same order;
+// a member for which factory returns nil is left out (eg, a sensor which has since been removed).
+// NewMutableAt makes a simple factory which keeps the saved timestamps:
+//
+//	l, err := statist.RestoreLineup(f, func(name, state string, t time.Time) statist.Statist {
+//		return statist.NewMutableAt(name, state, t)
+//	})
+func RestoreLineup(r io.Reader, factory func(name, state string, t time.Time) Statist) (Lineup, error) {
+	records, err := ReadSnapshot(r)
+	if err != nil {
+		return nil, err
+	}
+	l := NewLineup()
+	for _, rec := range records {
+		if s := factory(rec.Name, rec.State, rec.Time); s != nil {
+			l = Enlist(s, l)
+		}
+	}
+	return l, nil
+}
@@ -3,17 +3,38 @@
 //
 // A use-case for package is to periodically report sensor information on a schedule.
 // By making your sensors Statists, just Enlist them into a Lineup and make them sound off over MQTT.
+// When members come and go from several goroutines, keep them in a Registry instead.
 package statist
 
 import (
+	"errors"
+	"fmt"
 	"strings"
+	"time"
 )
 
+// ErrDuplicate is returned by EnlistUnique when the Lineup already has a member of the same Name() or IDOf
+var ErrDuplicate = errors.New("statist: duplicate member")
+
 type Statist interface {
 	StateString() string
 	Name() string
 }
 
+// Identifier may be implemented by a Statist whose stable ID differs from its human-readable Name();
+// the ID is used to tell members apart, so a Statist can be renamed without becoming a different member
+type Identifier interface {
+	ID() string
+}
+
+// IDOf returns the ID() of s if it is an Identifier, and its Name() otherwise
+func IDOf(s Statist) string {
+	if i, ok := AsIdentifier(s); ok {
+		return i.ID()
+	}
+	return s.Name()
+}
+
 type Lineup []Statist
 
 type Musterer interface {
@@ -33,44 +54,169 @@ func Enlist(s Statist, l Lineup) Lineup {
 	return l
 }
 
-// Desert will remove a Statist (by 'Name()') from a Registry and returns the new registry, or return existing if no match
-// Warning: Desert merely removes the first index matching s.Name() and does not check subsequent indicies
-// so unique names are encouraged yet unenforced
+// EnlistUnique pushes a Statist into a Lineup and returns the new Lineup, or returns the existing Lineup
+// and an error wrapping ErrDuplicate if a member already has its Name() or IDOf, so Desert removes the one intended
+func EnlistUnique(s Statist, l Lineup) (Lineup, error) {
+	if i := l.same(s); i >= 0 {
+		if IDOf(l[i]) == IDOf(s) {
+			return l, fmt.Errorf("%w: id %s", ErrDuplicate, IDOf(s))
+		}
+		return l, fmt.Errorf("%w: name %s", ErrDuplicate, s.Name())
+	}
+	return Enlist(s, l), nil
+}
+
+// EnlistOrReplace swaps a Statist into the place of the first member with the same Name() or IDOf (eg, a renamed sensor)
+// and returns the Lineup, or enlists it at the end if there is no such member
+func EnlistOrReplace(s Statist, l Lineup) Lineup {
+	if i := l.same(s); i >= 0 {
+		l[i] = s
+		return l
+	}
+	return Enlist(s, l)
+}
+
+// Desert will remove a Statist (by 'IDOf()') from a Registry and returns the new registry, or return existing if no match
+// Warning: Desert merely removes the first index matching IDOf(s) and does not check subsequent indicies
+// so unique IDs are encouraged yet unenforced (EnlistUnique enforces them)
 func Desert(s Statist, l Lineup) Lineup {
 	for i, v := range l {
-		if v.Name() == s.Name() {
+		if IDOf(v) == IDOf(s) {
 			return append(l[0:i], l[i+1:]...)
 		}
 	}
 	return l
 }
 
+// Names returns the Name() of each member, in enlistment order
+func (l Lineup) Names() []string {
+	names := make([]string, len(l))
+	for i, v := range l {
+		names[i] = v.Name()
+	}
+	return names
+}
+
+// Find returns the first member whose Name() is name, or false if there is none
+func (l Lineup) Find(name string) (Statist, bool) {
+	if i := l.index(name); i >= 0 {
+		return l[i], true
+	}
+	return nil, false
+}
+
+// index returns the index of the first member whose Name() is name, or -1 if there is none
+func (l Lineup) index(name string) int {
+	for i, v := range l {
+		if v.Name() == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// same returns the index of the first member with the same Name() or IDOf as s, or -1 if there is none
+func (l Lineup) same(s Statist) int {
+	name, id := s.Name(), IDOf(s)
+	for i, v := range l {
+		if v.Name() == name || IDOf(v) == id {
+			return i
+		}
+	}
+	return -1
+}
+
 // MusterWithGreeting is a sample implementation that sets a greeting (eg, the date/time when the muster was called)
 // and returns a multliline string of the StateString from each Statist in a Lineup;
 // this is cleaner when StateString() is implemented with care
 func (l Lineup) MusterWithGreeting(g string) string {
-	s := strings.Builder{}
-	s.Grow(1024)
-	s.WriteString(g)
-	s.WriteByte(NewLine())
-	for _, v := range l {
-		s.WriteString(v.StateString())
-		s.WriteByte(NewLine())
-	}
-	return s.String()
+	return l.muster(&g, nil)
 }
 
 // Muster does the same as MusterWithGreeting but sans greeting
 func (l Lineup) Muster() string {
+	return l.muster(nil, nil)
+}
+
+// musterAlerts musters the Lineup after the greeting g, if there is one, testing it against alerts
+func (l Lineup) musterAlerts(g *string, alerts ...*Alerts) string {
+	return l.muster(g, alerts)
+}
+
+// muster renders the Lineup after the greeting g, if there is one, then tests the states it read against alerts
+func (l Lineup) muster(g *string, alerts []*Alerts) string {
 	s := strings.Builder{}
 	s.Grow(1024)
-	for _, v := range l {
-		s.WriteString(v.StateString())
+	if g != nil {
+		s.WriteString(*g)
 		s.WriteByte(NewLine())
 	}
+	l.writeMembers(&s, nil, false, alerts...)
 	return s.String()
 }
 
+// writeMembers evaluates each Statist in dependency order, then writes one line per member in priority order
+// (rendered by f if it has a matching formatter), followed by any fields of a FieldsStatist and, if detailed,
+// the Detail() of a Detailer; a member with unmet dependencies is flagged rather than evaluated.
+// Each member's state, as read for its line, is then tested against the Rules of alerts, so nothing is read twice
+func (l Lineup) writeMembers(s *strings.Builder, f *Formatters, detailed bool, alerts ...*Alerts) {
+	lines, states, display := l.read(f, detailed)
+	for _, i := range display {
+		s.WriteString(lines[i])
+		s.WriteByte(NewLine())
+	}
+	l.alert(states, alerts)
+}
+
+// read evaluates the Lineup as writeMembers does, returning the lines and state of each member by index,
+// along with the indices in display order
+func (l Lineup) read(f *Formatters, detailed bool) (lines, states []string, display []int) {
+	lines = make([]string, len(l))
+	states = make([]string, len(l))
+	display = l.evaluate(func(i int, unmet []string) {
+		if len(unmet) > 0 {
+			lines[i] = unmetLine(l[i], unmet)
+			states[i] = lines[i]
+			return
+		}
+		line := f.line(l[i])
+		lines[i] = memberText(l[i], line, detailed)
+		if t, ok := AsTimed(l[i]); ok {
+			// still the state just read, as a TimedStatist's State reports its latest reading
+			states[i], _ = t.State()
+		} else {
+			states[i] = trimName(l[i], line)
+		}
+	})
+	return lines, states, display
+}
+
+// alert tests the states read from each member, by index, against the Rules of alerts
+func (l Lineup) alert(states []string, alerts []*Alerts) {
+	now := time.Now()
+	for _, a := range alerts {
+		for i, v := range l {
+			a.test(v.Name(), states[i], now)
+		}
+	}
+}
+
+// memberLines renders a single member as writeMembers does, without a trailing line feed
+func memberLines(v Statist, f *Formatters, detailed bool) string {
+	return memberText(v, f.line(v), detailed)
+}
+
+// memberText renders a member whose muster line has already been read
+func memberText(v Statist, line string, detailed bool) string {
+	b := strings.Builder{}
+	b.WriteString(line)
+	writeFields(&b, v)
+	if detailed {
+		writeDetail(&b, v)
+	}
+	return b.String()
+}
+
 /*
 	Helpers for formatting within a Muster
 */
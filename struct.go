@@ -0,0 +1,111 @@
+package statist
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// structStatist reports selected fields of an arbitrary struct
+type structStatist struct {
+	name   string
+	v      reflect.Value // the struct itself, addressed through a pointer if one was given
+	fields []structField
+}
+
+// structField is a field of a struct exposed via a `statist` tag
+type structField struct {
+	index []int
+	label string
+	unit  string
+}
+
+// FromStruct returns a Statist called name which reports the fields of v tagged like `statist:"temp,unit=°C"`;
+// untagged fields and fields tagged `statist:"-"` are ignored, and a field without a label uses its Go name.
+// Pass a pointer to a struct so later changes to its fields are reflected in StateString
+func FromStruct(name string, v any) (Statist, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, errors.New("statist: FromStruct given a nil pointer")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("statist: FromStruct given %s, not a struct", rv.Kind())
+	}
+	fields, err := structFields(rv.Type(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &structStatist{name: name, v: rv, fields: fields}, nil
+}
+
+// structFields collects the tagged fields of t, descending into embedded structs
+func structFields(t reflect.Type, index []int) ([]structField, error) {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		idx := append(append([]int{}, index...), i)
+		tag, ok := f.Tag.Lookup("statist")
+		if !ok {
+			if f.Anonymous && f.Type.Kind() == reflect.Struct {
+				embedded, err := structFields(f.Type, idx)
+				if err != nil {
+					return nil, err
+				}
+				fields = append(fields, embedded...)
+			}
+			continue
+		}
+		if tag == "-" {
+			continue
+		}
+		if !f.IsExported() {
+			return nil, fmt.Errorf("statist: tagged field %s is unexported", f.Name)
+		}
+		sf := structField{index: idx, label: f.Name}
+		opts := strings.Split(tag, ",")
+		if opts[0] != "" {
+			sf.label = opts[0]
+		}
+		for _, o := range opts[1:] {
+			k, v, _ := strings.Cut(o, "=")
+			switch k {
+			case "unit":
+				sf.unit = v
+			default:
+				return nil, fmt.Errorf("statist: unknown option %q on field %s", k, f.Name)
+			}
+		}
+		fields = append(fields, sf)
+	}
+	return fields, nil
+}
+
+// Name returns the name given to FromStruct
+func (s *structStatist) Name() string {
+	return s.name
+}
+
+// StateString returns the name followed by each tagged field, eg "Furnace: temp 21.3°C, humidity 40%"
+func (s *structStatist) StateString() string {
+	b := strings.Builder{}
+	b.WriteString(s.name)
+	b.WriteString(": ")
+	for i, f := range s.fields {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(f.label)
+		b.WriteByte(' ')
+		b.WriteString(s.value(f))
+	}
+	return b.String()
+}
+
+// value formats the current value of f along with its unit
+func (s *structStatist) value(f structField) string {
+	return fmt.Sprint(s.v.FieldByIndex(f.index).Interface()) + f.unit
+}
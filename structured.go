@@ -0,0 +1,80 @@
+package statist
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Entry is the structured form of a single member of a Lineup
+type Entry struct {
+	Name string `json:"name"`
+	// ID is the member's IDOf, omitted when it is the same as Name
+	ID string `json:"id,omitempty"`
+	// State is the member's StateString, or its own JSON if it is a json.Marshaler
+	State any `json:"state"`
+	// Since is when a TimedStatist's state was refreshed
+	Since *time.Time `json:"since,omitempty"`
+	// Fields holds the values of a FieldsStatist
+	Fields map[string]string `json:"fields,omitempty"`
+	// Detail holds the Detail() of a Detailer
+	Detail string `json:"detail,omitempty"`
+	// Unmet lists dependencies which kept the member from being evaluated, in which case State is a flagged line
+	Unmet []string `json:"unmet,omitempty"`
+}
+
+// Entries evaluates the Lineup as Muster does and returns an Entry per member, in the same order as a muster
+func (l Lineup) Entries() []Entry {
+	entries := make([]Entry, len(l))
+	display := l.evaluate(func(i int, unmet []string) {
+		v := l[i]
+		e := Entry{Name: v.Name()}
+		if id := IDOf(v); id != e.Name {
+			e.ID = id
+		}
+		if len(unmet) > 0 {
+			e.State = unmetLine(v, unmet)
+			e.Unmet = unmet
+			entries[i] = e
+			return
+		}
+		if m, ok := as[json.Marshaler](v); ok {
+			e.State = m
+		} else {
+			e.State = v.StateString()
+		}
+		if t, ok := AsTimed(v); ok {
+			_, since := t.State()
+			e.Since = &since
+		}
+		if f, ok := AsFields(v); ok {
+			e.Fields = f.Fields()
+		}
+		if d, ok := AsDetailer(v); ok {
+			e.Detail = d.Detail()
+		}
+		entries[i] = e
+	})
+	sorted := make([]Entry, 0, len(l))
+	for _, i := range display {
+		sorted = append(sorted, entries[i])
+	}
+	return sorted
+}
+
+// MusterJSON returns the Entries of the Lineup as a JSON array
+func (l Lineup) MusterJSON() ([]byte, error) {
+	return json.Marshal(l.Entries())
+}
+
+// MusterMap returns the StateString of each member keyed by Name(); where names collide, the last member wins
+func (l Lineup) MusterMap() map[string]string {
+	m := make(map[string]string, len(l))
+	l.evaluate(func(i int, unmet []string) {
+		if len(unmet) > 0 {
+			m[l[i].Name()] = unmetLine(l[i], unmet)
+			return
+		}
+		m[l[i].Name()] = l[i].StateString()
+	})
+	return m
+}
@@ -0,0 +1,76 @@
+package statist
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// tableHeader names the columns of MusterTable and MusterCSV
+var tableHeader = []string{"Name", "State", "Since"}
+
+// MusterTable returns the Lineup as aligned columns of Name, State and Since, headed by the column names;
+// State is the raw State of a TimedStatist and the StateString (less its leading "Name: ") of any other member,
+// and Since is blank if not Timed
+func (l Lineup) MusterTable() string {
+	s := strings.Builder{}
+	s.Grow(1024)
+	tw := tabwriter.NewWriter(&s, 0, 8, 2, ' ', 0)
+	for _, row := range append([][]string{tableHeader}, l.rows()...) {
+		for i, cell := range row {
+			if i > 0 {
+				tw.Write([]byte{Tab()})
+			}
+			// a tab or line feed in a cell would break the columns
+			tw.Write([]byte(strings.Map(flatten, cell)))
+		}
+		tw.Write([]byte{NewLine()})
+	}
+	tw.Flush()
+	return s.String()
+}
+
+// MusterCSV writes the same rows as MusterTable to w as CSV, headed by the column names
+func (l Lineup) MusterCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(tableHeader); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(l.rows()); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// rows evaluates the Lineup as Muster does and returns the Name, State and Since of each member, in muster order
+func (l Lineup) rows() [][]string {
+	rows := make([][]string, len(l))
+	display := l.evaluate(func(i int, unmet []string) {
+		v := l[i]
+		if len(unmet) > 0 {
+			rows[i] = []string{v.Name(), unmetLine(v, unmet), ""}
+			return
+		}
+		if t, ok := AsTimed(v); ok {
+			state, since := t.State()
+			rows[i] = []string{v.Name(), state, since.Format(time.RFC3339)}
+			return
+		}
+		rows[i] = []string{v.Name(), stateOf(v), ""}
+	})
+	sorted := make([][]string, 0, len(l))
+	for _, i := range display {
+		sorted = append(sorted, rows[i])
+	}
+	return sorted
+}
+
+// flatten replaces the tabs and line feeds within a table cell with spaces
+func flatten(r rune) rune {
+	if r == rune(Tab()) || r == rune(NewLine()) {
+		return ' '
+	}
+	return r
+}
@@ -0,0 +1,80 @@
+package statist
+
+import (
+	"strings"
+	"text/template"
+	"time"
+)
+
+// TemplateData is what a muster template is executed with
+type TemplateData struct {
+	Time    time.Time // when the muster was taken
+	Members []TemplateMember
+}
+
+// TemplateMember exposes a single member of a Lineup to a muster template
+type TemplateMember struct {
+	Name        string
+	ID          string
+	StateString string
+	Since       time.Time // when a TimedStatist was refreshed, or the zero Time for other members
+	Fields      map[string]string
+}
+
+// TemplateFuncs are the helpers for formatting within a Muster, for use in templates
+// (eg, template.New("muster").Funcs(statist.TemplateFuncs).Parse(...))
+var TemplateFuncs = template.FuncMap{
+	"check": func() string { return string(CheckMark()) },
+	"x":     func() string { return string(X()) },
+	"btc":   func() string { return string(Btc()) },
+	"tab":   func() string { return string(Tab()) },
+	// stamp formats a time as RFC 3339, or as an empty string if it is the zero Time
+	"stamp": func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	},
+}
+
+// DefaultTemplate is used by MusterTemplate when given a nil template, and renders like Muster;
+// it may be replaced to change the default layout
+var DefaultTemplate = template.Must(template.New("muster").Funcs(TemplateFuncs).Parse(
+	`{{range .Members}}{{.StateString}}
+{{range $k, $v := .Fields}}{{tab}}{{$k}}: {{$v}}
+{{end}}{{end}}`))
+
+// MusterTemplate evaluates the Lineup as Muster does and renders the result with tmpl, or DefaultTemplate if tmpl is nil
+func (l Lineup) MusterTemplate(tmpl *template.Template) (string, error) {
+	if tmpl == nil {
+		tmpl = DefaultTemplate
+	}
+	members := make([]TemplateMember, len(l))
+	display := l.evaluate(func(i int, unmet []string) {
+		v := l[i]
+		m := TemplateMember{Name: v.Name(), ID: IDOf(v)}
+		if len(unmet) > 0 {
+			m.StateString = unmetLine(v, unmet)
+			members[i] = m
+			return
+		}
+		m.StateString = v.StateString()
+		if t, ok := AsTimed(v); ok {
+			_, m.Since = t.State()
+		}
+		if f, ok := AsFields(v); ok {
+			m.Fields = f.Fields()
+		}
+		members[i] = m
+	})
+	data := TemplateData{Time: time.Now(), Members: make([]TemplateMember, 0, len(l))}
+	for _, i := range display {
+		data.Members = append(data.Members, members[i])
+	}
+	s := strings.Builder{}
+	s.Grow(1024)
+	if err := tmpl.Execute(&s, data); err != nil {
+		return "", err
+	}
+	return s.String(), nil
+}
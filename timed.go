@@ -0,0 +1,42 @@
+package statist
+
+import (
+	"strings"
+	"time"
+)
+
+// TimedStatist may be implemented by a Statist which knows when its state was last refreshed;
+// State returns the raw state and the time it was read or last changed
+type TimedStatist interface {
+	State() (string, time.Time)
+}
+
+// Stale returns the TimedStatists in the Lineup whose state was refreshed more than maxAge ago
+// (eg, sensors which have stopped reporting); members which are not TimedStatists are never stale
+func (l Lineup) Stale(maxAge time.Duration) Lineup {
+	now := time.Now()
+	stale := NewLineup()
+	for _, v := range l {
+		if t, ok := AsTimed(v); ok {
+			if _, at := t.State(); now.Sub(at) > maxAge {
+				stale = Enlist(v, stale)
+			}
+		}
+	}
+	return stale
+}
+
+// stateOf returns the raw state of s: the State of a TimedStatist,
+// or the StateString of any other Statist less the leading "Name: " it conventionally starts with
+func stateOf(s Statist) string {
+	if t, ok := AsTimed(s); ok {
+		state, _ := t.State()
+		return state
+	}
+	return trimName(s, s.StateString())
+}
+
+// trimName strips the leading "Name: " from line, the StateString of s
+func trimName(s Statist, line string) string {
+	return strings.TrimPrefix(line, s.Name()+": ")
+}
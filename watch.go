@@ -0,0 +1,68 @@
+package statist
+
+import (
+	"time"
+)
+
+// subscriptionBuffer is how many events a subscription holds before further events are dropped
+const subscriptionBuffer = 16
+
+// StateEvent describes a Statist's transition from one state to another
+type StateEvent struct {
+	Name string
+	Old  string
+	New  string
+	Time time.Time
+}
+
+// Watchable may be implemented by a Statist which can announce its own state changes;
+// Watch arranges for fn to be called on every change and returns a function which stops it
+type Watchable interface {
+	Watch(fn func(StateEvent)) (cancel func())
+}
+
+// subscription is a channel of events for one member, or all members if name is empty
+type subscription struct {
+	name string
+	ch   chan StateEvent
+}
+
+// Subscribe returns a channel of the state changes of the Watchable members named name, or of every
+// Watchable member if name is empty, along with a function which ends the subscription and closes the channel;
+// events are dropped rather than delivered late if the subscriber falls more than a few events behind
+func (r *Registry) Subscribe(name string) (<-chan StateEvent, func()) {
+	sub := &subscription{name: name, ch: make(chan StateEvent, subscriptionBuffer)}
+	r.subMu.Lock()
+	if r.subs == nil {
+		r.subs = make(map[*subscription]struct{})
+	}
+	r.subs[sub] = struct{}{}
+	r.subMu.Unlock()
+	cancel := func() {
+		r.subMu.Lock()
+		defer r.subMu.Unlock()
+		if _, ok := r.subs[sub]; ok {
+			delete(r.subs, sub)
+			close(sub.ch)
+		}
+	}
+	return sub.ch, cancel
+}
+
+// publish delivers e to every interested subscription, then observes it with any attached Alerts
+func (r *Registry) publish(e StateEvent) {
+	r.subMu.Lock()
+	for sub := range r.subs {
+		if sub.name != "" && sub.name != e.Name {
+			continue
+		}
+		select {
+		case sub.ch <- e:
+		default:
+		}
+	}
+	r.subMu.Unlock()
+	for _, a := range r.attached() {
+		a.Observe(e)
+	}
+}
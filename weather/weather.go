@@ -0,0 +1,165 @@
+// package weather provides a Statist reporting current outdoor conditions from an Open-Meteo style weather API,
+// so that reference values appear alongside indoor sensors in a muster.
+package weather
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eyelight/statist"
+)
+
+// OpenMeteo is the forecast endpoint of the free Open-Meteo API
+const OpenMeteo = "https://api.open-meteo.com/v1/forecast"
+
+// retryAfter is how soon a failed fetch is retried
+const retryAfter = time.Minute
+
+// defaultClient makes requests for a Current without a Client
+var defaultClient = &http.Client{Timeout: 10 * time.Second}
+
+// Current is a Statist reporting the current conditions at a location, fetched at most once per TTL
+type Current struct {
+	name      string
+	latitude  float64
+	longitude float64
+
+	// URL is the forecast endpoint; it defaults to OpenMeteo, and any API accepting the same query will do
+	URL string
+	// Variables are the current-conditions variables requested and reported, in order
+	Variables []string
+	// TTL is how long fetched conditions are reused before fetching again
+	TTL time.Duration
+	// Client makes the requests; it defaults to a client with a ten second timeout
+	Client *http.Client
+
+	mu       sync.Mutex
+	values   []string // formatted with units, in the order of Variables
+	next     time.Time
+	lastErr  error
+	fetching chan struct{} // closed once the fetch in progress, if any, completes
+}
+
+// New returns a Current called name for the given location, reporting temperature, humidity, and wind speed
+// refreshed every fifteen minutes (which is how often Open-Meteo updates current conditions)
+func New(name string, latitude, longitude float64) *Current {
+	return &Current{
+		name:      name,
+		latitude:  latitude,
+		longitude: longitude,
+		URL:       OpenMeteo,
+		Variables: []string{"temperature_2m", "relative_humidity_2m", "wind_speed_10m"},
+		TTL:       15 * time.Minute,
+		Client:    defaultClient,
+	}
+}
+
+// Name returns the name given to New
+func (c *Current) Name() string {
+	return c.name
+}
+
+// StateString returns the current conditions, eg "Outside: 12.3°C 81% 14.2km/h";
+// if a fetch fails, the last conditions fetched are reported until a retry succeeds
+func (c *Current) StateString() string {
+	c.refresh()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.values == nil {
+		return c.name + ": " + string(statist.X()) + " fetch failed"
+	}
+	return c.name + ": " + strings.Join(c.values, " ")
+}
+
+// Detail returns the error from the most recent failed fetch, if any
+func (c *Current) Detail() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.lastErr == nil {
+		return ""
+	}
+	return c.lastErr.Error()
+}
+
+// Describe returns the statist's metadata
+func (c *Current) Describe() statist.Description {
+	return statist.Description{Type: "weather", URL: "https://open-meteo.com/en/docs"}
+}
+
+// refresh fetches the current conditions if the cached ones have expired, without holding c.mu during the request
+// so Detail is never held up; a caller arriving during a fetch waits for it rather than starting another
+func (c *Current) refresh() {
+	c.mu.Lock()
+	now := time.Now()
+	if now.Before(c.next) {
+		c.mu.Unlock()
+		return
+	}
+	if ch := c.fetching; ch != nil {
+		c.mu.Unlock()
+		<-ch
+		return
+	}
+	ch := make(chan struct{})
+	c.fetching = ch
+	c.mu.Unlock()
+
+	values, err := c.fetch()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastErr = err
+	if err != nil {
+		c.next = now.Add(retryAfter)
+	} else {
+		c.values = values
+		c.next = now.Add(c.TTL)
+	}
+	c.fetching = nil
+	close(ch)
+}
+
+// response is the part of a forecast response holding current conditions
+type response struct {
+	Units   map[string]string          `json:"current_units"`
+	Current map[string]json.RawMessage `json:"current"`
+}
+
+// fetch requests the current conditions and formats each variable with its unit
+func (c *Current) fetch() ([]string, error) {
+	q := url.Values{}
+	q.Set("latitude", strconv.FormatFloat(c.latitude, 'f', -1, 64))
+	q.Set("longitude", strconv.FormatFloat(c.longitude, 'f', -1, 64))
+	q.Set("current", strings.Join(c.Variables, ","))
+	client := c.Client
+	if client == nil {
+		client = defaultClient
+	}
+	resp, err := client.Get(c.URL + "?" + q.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("weather: %s from %s", resp.Status, c.URL)
+	}
+	var r response
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return nil, fmt.Errorf("weather: decoding response: %w", err)
+	}
+	values := make([]string, 0, len(c.Variables))
+	for _, v := range c.Variables {
+		raw, ok := r.Current[v]
+		if !ok {
+			return nil, fmt.Errorf("weather: response has no current %s", v)
+		}
+		values = append(values, strings.Trim(string(raw), `"`)+r.Units[v])
+	}
+	return values, nil
+}